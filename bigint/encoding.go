@@ -0,0 +1,71 @@
+package bigint
+
+import (
+	"github.com/pioniro/segment-go"
+	"math/big"
+)
+
+func init() {
+	segment.RegisterDefaultValue(Int, ParseInt)
+	segment.RegisterDefaultValue(Rat, ParseRat)
+}
+
+// MarshalJSON encodes a segment as an object with integers as JSON numbers, see segment.MarshalSegmentJSON.
+func (s *IntSegment) MarshalJSON() ([]byte, error) {
+	return segment.MarshalSegmentJSON[*big.Int](s)
+}
+
+// UnmarshalJSON decodes a segment from an object or from a string in interval notation.
+func (s *IntSegment) UnmarshalJSON(data []byte) error {
+	from, till, err := segment.UnmarshalSegmentJSON(data, Int, ParseInt)
+	if err != nil {
+		return err
+	}
+	*s = *NewIntSegment(from, till)
+	return nil
+}
+
+// MarshalText encodes a segment in interval notation, see String.
+func (s *IntSegment) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a segment in interval notation: [1;2), (-inf;5].
+func (s *IntSegment) UnmarshalText(text []byte) error {
+	seg, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*s = *seg
+	return nil
+}
+
+// MarshalJSON encodes a segment as an object with rationals as strings ("1/3"), see segment.MarshalSegmentJSON.
+func (s *RatSegment) MarshalJSON() ([]byte, error) {
+	return segment.MarshalSegmentJSON[*big.Rat](s)
+}
+
+// UnmarshalJSON decodes a segment from an object or from a string in interval notation.
+func (s *RatSegment) UnmarshalJSON(data []byte) error {
+	from, till, err := segment.UnmarshalSegmentJSON(data, Rat, ParseRat)
+	if err != nil {
+		return err
+	}
+	*s = *NewRatSegment(from, till)
+	return nil
+}
+
+// MarshalText encodes a segment in interval notation, see String.
+func (s *RatSegment) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a segment in interval notation: [1/3;1/2), (-inf;0.25].
+func (s *RatSegment) UnmarshalText(text []byte) error {
+	seg, err := ParseRatSegment(string(text))
+	if err != nil {
+		return err
+	}
+	*s = *seg
+	return nil
+}
@@ -0,0 +1,42 @@
+package bigint
+
+import (
+	"github.com/pioniro/segment-go"
+)
+
+// Parse parses an integer segment in interval notation, that is produced by String: [1;2), (-inf;18446744073709551616].
+// See segment.ParseBorders for details.
+func Parse(s string) (*IntSegment, error) {
+	from, till, err := segment.ParseBorders(s, ParseInt)
+	if err != nil {
+		return nil, err
+	}
+	return NewIntSegment(from, till), nil
+}
+
+// MustParse is like Parse, but panics if a string cannot be parsed.
+func MustParse(s string) *IntSegment {
+	seg, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return seg
+}
+
+// ParseRatSegment parses a rational segment in interval notation, that is produced by String: [1/3;1/2), (-inf;0.25].
+func ParseRatSegment(s string) (*RatSegment, error) {
+	from, till, err := segment.ParseBorders(s, ParseRat)
+	if err != nil {
+		return nil, err
+	}
+	return NewRatSegment(from, till), nil
+}
+
+// MustParseRatSegment is like ParseRatSegment, but panics if a string cannot be parsed.
+func MustParseRatSegment(s string) *RatSegment {
+	seg, err := ParseRatSegment(s)
+	if err != nil {
+		panic(err)
+	}
+	return seg
+}
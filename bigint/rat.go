@@ -0,0 +1,106 @@
+package bigint
+
+import (
+	gen "github.com/pioniro/generator-go"
+	"github.com/pioniro/segment-go"
+	"iter"
+	"math/big"
+)
+
+// RatSegment is an implementation of ISegment, TryToSegment, IncludedSegment interfaces for exact rational numbers.
+// Rationals are continuous, so bounds of borders can't be changed and (1/3;1/2) is not the same as [1/3;1/2].
+type RatSegment struct {
+	from segment.Border[*big.Rat]
+	till segment.Border[*big.Rat]
+}
+
+func NewRatSegment(from, till segment.Border[*big.Rat]) *RatSegment {
+	return &RatSegment{
+		from: from.AsFrom(),
+		till: till.AsTill(),
+	}
+}
+
+func (s *RatSegment) From() *segment.Border[*big.Rat] {
+	return &s.from
+}
+
+func (s *RatSegment) Till() *segment.Border[*big.Rat] {
+	return &s.till
+}
+
+// TryTo returns a copy of a segment if bounds are not changed, otherwise ErrNotDiscrete is returned.
+func (s *RatSegment) TryTo(from segment.Bound, till segment.Bound) (segment.TryToSegment[*big.Rat], error) {
+	f, err := segment.LeftBoundTo(s.from, from)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := segment.RightBoundTo(s.till, till)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRatSegment(f, t), nil
+}
+
+// String returns a string representation of a segment.
+// example: [1/3;1/2), (-inf;5]
+func (s *RatSegment) String() string {
+	return segment.Format[*big.Rat](s)
+}
+
+func (s *RatSegment) IsEmpty() bool {
+	return segment.IsEmptyBorders(s.from, s.till, compareRat)
+}
+
+func (s *RatSegment) IsIncludes(point *big.Rat) bool {
+	return segment.IncludesFrom(s.from, point, compareRat) && segment.IncludesTill(s.till, point, compareRat)
+}
+
+// Size returns a measure of a segment: till - from. Bounds don't matter, so Size( (1/3; 1] ) == Size( [1/3; 1] ) == 2/3.
+// An empty segment has zero size. If a segment is unbound, then ErrSegmentTooBig will be returned.
+func (s *RatSegment) Size() (*big.Rat, error) {
+	if s.IsEmpty() {
+		return new(big.Rat), nil
+	}
+	if s.from.IsUnbound() || s.till.IsUnbound() {
+		return nil, segment.ErrSegmentTooBig
+	}
+	return new(big.Rat).Sub(s.till.Value().Value(), s.from.Value().Value()), nil
+}
+
+// Split splits a segment into chunks of a given measure: (A; B] -> (A; A+size), [A+size; A+size*2), ... [A+size*N; B].
+// Borders of a segment are kept by the first and the last chunks, other chunks are [a;b).
+// If size is not positive or from is unbound, then empty gen will be returned. If till is unbound, then gen is infinite.
+func (s *RatSegment) Split(size *big.Rat) gen.Generator[*RatSegment] {
+	return func(yield gen.Yield[*RatSegment]) {
+		for chunk := range s.Chunks(size) {
+			if !yield(chunk, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Chunks returns an iterator over chunks of a segment, see Split.
+func (s *RatSegment) Chunks(size *big.Rat) iter.Seq[*RatSegment] {
+	return func(yield func(*RatSegment) bool) {
+		if size.Sign() <= 0 || s.from.IsUnbound() || s.IsEmpty() {
+			return
+		}
+		from := s.from
+		for {
+			r := new(big.Rat).Add(from.Value().Value(), size)
+			// the last chunk keeps a till border of a segment
+			if !s.till.IsUnbound() && r.Cmp(s.till.Value().Value()) >= 0 {
+				yield(NewRatSegment(from, s.till))
+				return
+			}
+			if !yield(NewRatSegment(from, segment.NewExcluded(Rat(r)))) {
+				return
+			}
+			from = segment.NewIncluded(Rat(r))
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package bigint
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/pioniro/segment-go"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestRatSegment_IsEmpty(t *testing.T) {
+	tests := map[string]bool{
+		"(1/3;1/3)":  true,
+		"[1/3;1/3]":  false,
+		"(1/3;0.34)": false,
+		"[1/2;1/3]":  true,
+		"(-inf;0)":   false,
+	}
+	for input, want := range tests {
+		if got := MustParseRatSegment(input).IsEmpty(); got != want {
+			t.Errorf("IsEmpty(%s) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestRatSegment_IsIncludes(t *testing.T) {
+	s := MustParseRatSegment("(1/3;1/2]")
+	tests := []struct {
+		point *big.Rat
+		want  bool
+	}{
+		{point: big.NewRat(1, 3), want: false},
+		{point: big.NewRat(333333334, 1000000000), want: true},
+		{point: big.NewRat(1, 2), want: true},
+		{point: big.NewRat(500000001, 1000000000), want: false},
+	}
+	for _, tt := range tests {
+		if got := s.IsIncludes(tt.point); got != tt.want {
+			t.Errorf("IsIncludes(%v) = %v, want %v", tt.point, got, tt.want)
+		}
+	}
+	if _, err := s.TryTo(segment.Included, segment.Included); !errors.Is(err, segment.ErrNotDiscrete) {
+		t.Errorf("TryTo() error = %v, want ErrNotDiscrete", err)
+	}
+}
+
+func TestRatSegment_Size(t *testing.T) {
+	got, err := MustParseRatSegment("(1/3;1]").Size()
+	if err != nil || got.RatString() != "2/3" {
+		t.Errorf("Size() = %v, %v", got, err)
+	}
+	if _, err := MustParseRatSegment("[0;+inf)").Size(); !errors.Is(err, segment.ErrSegmentTooBig) {
+		t.Errorf("Size() error = %v, want ErrSegmentTooBig", err)
+	}
+}
+
+func TestRatSegment_Chunks(t *testing.T) {
+	tests := []struct {
+		input string
+		size  *big.Rat
+		want  string
+	}{
+		{input: "(0;1]", size: big.NewRat(1, 3), want: "(0;1/3) [1/3;2/3) [2/3;1]"},
+		{input: "[0;1)", size: big.NewRat(2, 5), want: "[0;2/5) [2/5;4/5) [4/5;1)"},
+		{input: "[1/2;+inf)", size: big.NewRat(1, 2), want: "[1/2;1) [1;3/2) [3/2;2)"},
+		{input: "(-inf;1)", size: big.NewRat(1, 2), want: ""},
+		{input: "[0;1]", size: big.NewRat(0, 1), want: ""},
+	}
+	for _, tt := range tests {
+		var parts []string
+		for chunk := range MustParseRatSegment(tt.input).Chunks(tt.size) {
+			parts = append(parts, chunk.String())
+			if len(parts) == 3 {
+				break
+			}
+		}
+		if got := strings.Join(parts, " "); got != tt.want {
+			t.Errorf("Chunks(%s, %v) = %v, want %v", tt.input, tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestRatSegment_JSON(t *testing.T) {
+	s := MustParseRatSegment("(1/3;+inf)")
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"from":{"bound":"excluded","value":"1/3"},"till":{"bound":"unbound"}}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	for _, input := range []string{want, `"(1/3;+inf)"`} {
+		var got RatSegment
+		if err := json.Unmarshal([]byte(input), &got); err != nil || got.String() != s.String() {
+			t.Errorf("Unmarshal(%s) = %v, %v", input, &got, err)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package bigint
+
+import (
+	gen "github.com/pioniro/generator-go"
+	"github.com/pioniro/segment-go"
+	"iter"
+	"math/big"
+)
+
+// IntSegment is an implementation of ISegment, TryToSegment, IncludedSegment, IterableSegment interfaces for integers of any size.
+// Integers are discrete: [1;5) == [1;4] == (0;4]. Unbound borders stay unbound, so (-inf;+inf) includes every integer.
+type IntSegment struct {
+	from segment.Border[*big.Int]
+	till segment.Border[*big.Int]
+}
+
+func NewIntSegment(from, till segment.Border[*big.Int]) *IntSegment {
+	return &IntSegment{
+		from: from.AsFrom(),
+		till: till.AsTill(),
+	}
+}
+
+func (s *IntSegment) From() *segment.Border[*big.Int] {
+	return &s.from
+}
+
+func (s *IntSegment) Till() *segment.Border[*big.Int] {
+	return &s.till
+}
+
+// TryTo tries to create a new segment from a given segment, but with different borders.
+// Integers always have next and prev values, so it never fails.
+func (s *IntSegment) TryTo(from segment.Bound, till segment.Bound) (segment.TryToSegment[*big.Int], error) {
+	f, err := segment.LeftBoundTo(s.from, from)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := segment.RightBoundTo(s.till, till)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIntSegment(f, t), nil
+}
+
+// String returns a string representation of a segment.
+// example: [1;2), (-inf;340282366920938463463374607431768211456]
+func (s *IntSegment) String() string {
+	return segment.Format[*big.Int](s)
+}
+
+func (s *IntSegment) IsEmpty() bool {
+	from, till := s.canonical()
+	return segment.IsEmptyBorders(from, till, compareInt)
+}
+
+func (s *IntSegment) IsIncludes(point *big.Int) bool {
+	return segment.IncludesFrom(s.from, point, compareInt) && segment.IncludesTill(s.till, point, compareInt)
+}
+
+// Size returns a number of integers in a segment: Size( (2; 5) ) == 2.
+// If a segment is unbound, then it is infinite and ErrSegmentTooBig will be returned.
+func (s *IntSegment) Size() (*big.Int, error) {
+	if s.IsEmpty() {
+		return new(big.Int), nil
+	}
+	if s.from.IsUnbound() || s.till.IsUnbound() {
+		return nil, segment.ErrSegmentTooBig
+	}
+	from, till := s.canonical()
+	size := new(big.Int).Sub(till.Value().Value(), from.Value().Value())
+	return size.Add(size, big.NewInt(1)), nil
+}
+
+// Split splits a segment into chunks of a given size: [A; B] -> [A; A+size), [A+size; A+size*2), ... [A+size*N; B].
+// If size less than 1 or from is unbound, then empty gen will be returned. If till is unbound, then gen is infinite.
+func (s *IntSegment) Split(size *big.Int) gen.Generator[*IntSegment] {
+	return func(yield gen.Yield[*IntSegment]) {
+		for chunk := range s.Chunks(size) {
+			if !yield(chunk, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Chunks returns an iterator over chunks of a segment, see Split.
+func (s *IntSegment) Chunks(size *big.Int) iter.Seq[*IntSegment] {
+	return func(yield func(*IntSegment) bool) {
+		if size.Sign() <= 0 || s.from.IsUnbound() || s.IsEmpty() {
+			return
+		}
+		from, till := s.canonical()
+		l := from.Value().Value()
+		for {
+			r := new(big.Int).Add(l, size)
+			// the last chunk is [l; finish], it is the only chunk with an Included right border
+			if !till.IsUnbound() && r.Cmp(till.Value().Value()) > 0 {
+				yield(NewIntSegment(segment.NewIncluded(Int(l)), till))
+				return
+			}
+			if !yield(NewIntSegment(segment.NewIncluded(Int(l)), segment.NewExcluded(Int(r)))) {
+				return
+			}
+			if !till.IsUnbound() && r.Cmp(till.Value().Value()) == 0 {
+				yield(NewIntSegment(segment.NewIncluded(Int(r)), till))
+				return
+			}
+			l = r
+		}
+	}
+}
+
+// Iterate returns a generator of all integers of a segment.
+// If from is unbound, then empty gen will be returned. If till is unbound, then gen is infinite.
+func (s *IntSegment) Iterate() gen.Generator[*big.Int] {
+	return segment.FromSeq(s.All())
+}
+
+// All returns an iterator over all integers of a segment in ascending order, see Iterate.
+// Each yielded integer is a new one, so it can be kept or modified.
+func (s *IntSegment) All() iter.Seq[*big.Int] {
+	return func(yield func(*big.Int) bool) {
+		if s.from.IsUnbound() || s.IsEmpty() {
+			return
+		}
+		from, till := s.canonical()
+		one := big.NewInt(1)
+		for i := new(big.Int).Set(from.Value().Value()); till.IsUnbound() || i.Cmp(till.Value().Value()) <= 0; i = new(big.Int).Add(i, one) {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// canonical returns borders of a segment converted to included ones: (1;5) -> [2;4]
+// Integers always have next and prev values, so the conversion never fails.
+func (s *IntSegment) canonical() (segment.Border[*big.Int], segment.Border[*big.Int]) {
+	from, _ := segment.LeftBoundTo(s.from, segment.Included)
+	till, _ := segment.RightBoundTo(s.till, segment.Included)
+	return from, till
+}
@@ -0,0 +1,143 @@
+package bigint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/pioniro/segment-go"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func chunks(s *IntSegment, size int64) string {
+	var parts []string
+	for chunk := range s.Chunks(big.NewInt(size)) {
+		parts = append(parts, chunk.String())
+		if len(parts) == 5 {
+			break
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestIntSegment_IsEmpty(t *testing.T) {
+	tests := map[string]bool{
+		"[1;1]":      false,
+		"[1;1)":      true,
+		"(1;2)":      true,
+		"(1;3)":      false,
+		"(-inf;inf)": false,
+		"(5;+inf)":   false,
+		"(-inf;-5)":  false,
+	}
+	for input, want := range tests {
+		if got := MustParse(input).IsEmpty(); got != want {
+			t.Errorf("IsEmpty(%s) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestIntSegment_IsIncludes(t *testing.T) {
+	s := MustParse("(18446744073709551615;+inf)")
+	if s.IsIncludes(new(big.Int).SetUint64(^uint64(0))) {
+		t.Errorf("IsIncludes(max uint64) = true")
+	}
+	huge, _ := new(big.Int).SetString("1"+strings.Repeat("0", 100), 10)
+	if !s.IsIncludes(huge) {
+		t.Errorf("IsIncludes(1e100) = false")
+	}
+}
+
+func TestIntSegment_Size(t *testing.T) {
+	tests := map[string]string{
+		"(2;5)": "2",
+		"[5;2]": "0",
+		"[-340282366920938463463374607431768211456;340282366920938463463374607431768211456)": "680564733841876926926749214863536422912",
+	}
+	for input, want := range tests {
+		got, err := MustParse(input).Size()
+		if err != nil || got.String() != want {
+			t.Errorf("Size(%s) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+	for _, input := range []string{"[0;+inf)", "(-inf;0]"} {
+		if _, err := MustParse(input).Size(); !errors.Is(err, segment.ErrSegmentTooBig) {
+			t.Errorf("Size(%s) error = %v, want ErrSegmentTooBig", input, err)
+		}
+	}
+	if _, err := MustParse("(-inf;0)").TryTo(segment.Included, segment.Included); err != nil {
+		t.Errorf("TryTo() error = %v", err)
+	}
+}
+
+func TestIntSegment_Chunks(t *testing.T) {
+	tests := []struct {
+		input string
+		size  int64
+		want  string
+	}{
+		{input: "[0;10]", size: 5, want: "[0;5) [5;10) [10;10]"},
+		{input: "(0;10)", size: 4, want: "[1;5) [5;9) [9;9]"},
+		{input: "[0;10)", size: 20, want: "[0;9]"},
+		{input: "[18446744073709551614;+inf)", size: 2, want: "[18446744073709551614;18446744073709551616) [18446744073709551616;18446744073709551618) [18446744073709551618;18446744073709551620) [18446744073709551620;18446744073709551622) [18446744073709551622;18446744073709551624)"},
+		{input: "(-inf;0]", size: 2, want: ""},
+		{input: "[0;1]", size: 0, want: ""},
+		{input: "(1;2)", size: 1, want: ""},
+	}
+	for _, tt := range tests {
+		if got := chunks(MustParse(tt.input), tt.size); got != tt.want {
+			t.Errorf("Chunks(%s, %d) = %v, want %v", tt.input, tt.size, got, tt.want)
+		}
+	}
+	var got []string
+	MustParse("[0;3)").Split(big.NewInt(2))(func(s *IntSegment, err error) bool {
+		got = append(got, s.String())
+		return err == nil
+	})
+	if fmt.Sprint(got) != "[[0;2) [2;2]]" {
+		t.Errorf("Split() = %v", got)
+	}
+}
+
+func TestIntSegment_Iterate(t *testing.T) {
+	var got []*big.Int
+	for v := range MustParse("(18446744073709551614;+inf)").All() {
+		got = append(got, v)
+		if len(got) == 3 {
+			break
+		}
+	}
+	if fmt.Sprint(got) != "[18446744073709551615 18446744073709551616 18446744073709551617]" {
+		t.Errorf("All() = %v", got)
+	}
+	var values []string
+	MustParse("[-1;1]").Iterate()(func(v *big.Int, err error) bool {
+		values = append(values, v.String())
+		return err == nil
+	})
+	if fmt.Sprint(values) != "[-1 0 1]" {
+		t.Errorf("Iterate() = %v", values)
+	}
+	for range MustParse("(-inf;1]").All() {
+		t.Fatalf("All() of unbound from is not empty")
+	}
+}
+
+func TestIntSegment_JSON(t *testing.T) {
+	s := MustParse("[18446744073709551616;+inf)")
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"from":{"bound":"included","value":18446744073709551616},"till":{"bound":"unbound"}}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	for _, input := range []string{want, `"[18446744073709551616;+inf)"`} {
+		var got IntSegment
+		if err := json.Unmarshal([]byte(input), &got); err != nil || got.String() != s.String() {
+			t.Errorf("Unmarshal(%s) = %v, %v", input, &got, err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Package bigint provides segments of arbitrary-precision numbers: discrete *big.Int and continuous *big.Rat values.
+// Their sizes, chunks and iteration never overflow.
+package bigint
+
+import (
+	"github.com/pioniro/segment-go"
+	"math/big"
+	"strconv"
+)
+
+type intValue struct {
+	value *big.Int
+}
+
+// Int returns a discrete value of an integer. A value is copied, so it can be modified after the call.
+// A result of Value must not be modified.
+func Int(v *big.Int) segment.Value[*big.Int] {
+	return &intValue{
+		value: new(big.Int).Set(v),
+	}
+}
+
+func (v *intValue) Value() *big.Int {
+	return v.value
+}
+
+func (v *intValue) String() string {
+	return v.value.String()
+}
+
+// Next returns v+1, it never fails.
+func (v *intValue) Next() (segment.Value[*big.Int], error) {
+	return &intValue{value: new(big.Int).Add(v.value, big.NewInt(1))}, nil
+}
+
+// Prev returns v-1, it never fails.
+func (v *intValue) Prev() (segment.Value[*big.Int], error) {
+	return &intValue{value: new(big.Int).Sub(v.value, big.NewInt(1))}, nil
+}
+
+type ratValue struct {
+	value *big.Rat
+}
+
+// Rat returns a continuous value of a rational number, see segment.Continuous.
+// A value is copied, so it can be modified after the call. A result of Value must not be modified.
+func Rat(v *big.Rat) segment.Value[*big.Rat] {
+	return &ratValue{
+		value: new(big.Rat).Set(v),
+	}
+}
+
+func (v *ratValue) Continuous() {}
+
+func (v *ratValue) Value() *big.Rat {
+	return v.value
+}
+
+// String returns an exact representation of a value: 3, -1/3.
+func (v *ratValue) String() string {
+	return v.value.RatString()
+}
+
+// Next returns ErrNotDiscrete, because there is no next rational number.
+func (v *ratValue) Next() (segment.Value[*big.Rat], error) {
+	return v, segment.ErrNotDiscrete
+}
+
+// Prev returns ErrNotDiscrete, because there is no prev rational number.
+func (v *ratValue) Prev() (segment.Value[*big.Rat], error) {
+	return v, segment.ErrNotDiscrete
+}
+
+// ParseInt parses a decimal representation of an integer of any size: 340282366920938463463374607431768211456.
+func ParseInt(s string) (segment.Value[*big.Int], error) {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, &strconv.NumError{Func: "ParseInt", Num: s, Err: strconv.ErrSyntax}
+	}
+	return &intValue{value: v}, nil
+}
+
+// ParseRat parses a fraction or a decimal representation of a rational number: 1/3, -0.25, 1e-3.
+func ParseRat(s string) (segment.Value[*big.Rat], error) {
+	v, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, &strconv.NumError{Func: "ParseRat", Num: s, Err: strconv.ErrSyntax}
+	}
+	return &ratValue{value: v}, nil
+}
+
+func compareInt(a, b *big.Int) int {
+	return a.Cmp(b)
+}
+
+func compareRat(a, b *big.Rat) int {
+	return a.Cmp(b)
+}
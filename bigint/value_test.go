@@ -0,0 +1,56 @@
+package bigint
+
+import (
+	"errors"
+	"github.com/pioniro/segment-go"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+func TestInt_NextPrev(t *testing.T) {
+	maxUint64 := new(big.Int).SetUint64(^uint64(0))
+	v := Int(maxUint64)
+	next, err := v.Next()
+	if err != nil || next.String() != "18446744073709551616" {
+		t.Errorf("Next() = %v, %v", next, err)
+	}
+	prev, err := Int(big.NewInt(0)).Prev()
+	if err != nil || prev.String() != "-1" {
+		t.Errorf("Prev() = %v, %v", prev, err)
+	}
+	// values are copied
+	maxUint64.SetInt64(0)
+	if v.String() != "18446744073709551615" {
+		t.Errorf("Int() = %v, want a copy", v)
+	}
+}
+
+func TestRat_NextPrev(t *testing.T) {
+	v := Rat(big.NewRat(1, 3))
+	if !segment.IsContinuous(v) {
+		t.Errorf("IsContinuous() = false")
+	}
+	if _, err := v.Next(); !errors.Is(err, segment.ErrNotDiscrete) {
+		t.Errorf("Next() error = %v, want ErrNotDiscrete", err)
+	}
+	if _, err := v.Prev(); !errors.Is(err, segment.ErrNotDiscrete) {
+		t.Errorf("Prev() error = %v, want ErrNotDiscrete", err)
+	}
+	if v.String() != "1/3" || Rat(big.NewRat(4, 2)).String() != "2" {
+		t.Errorf("String() = %v", v)
+	}
+}
+
+func TestParse_Values(t *testing.T) {
+	if _, err := ParseInt("1.5"); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("ParseInt() error = %v, want ErrSyntax", err)
+	}
+	if _, err := ParseRat("1/0"); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("ParseRat() error = %v, want ErrSyntax", err)
+	}
+	v, err := ParseRat("-0.25")
+	if err != nil || v.String() != "-1/4" {
+		t.Errorf("ParseRat() = %v, %v", v, err)
+	}
+}
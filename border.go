@@ -47,6 +47,10 @@ func (b *Border[T]) Value() Value[T] {
 
 func (b *Border[T]) String() string {
 	if b.IsUnbound() {
+		// -inf or +inf if a side of a border is known
+		if b.value != nil {
+			return b.value.String()
+		}
 		return "inf"
 	}
 	return b.value.String()
@@ -59,9 +63,95 @@ func NewExcluded[T any](value Value[T]) Border[T] {
 	return NewBorder(Excluded, value)
 }
 
+// NewUnbound creates an unbound border, that does not know its side, so its value is Inf.
+// Segments turn it into NewLeftUnbound or NewRightUnbound depending on the side of a border.
 func NewUnbound[T any]() Border[T] {
 	return Border[T]{
 		bound: Unbound,
 		value: Inf[T](),
 	}
 }
+
+// NewLeftUnbound creates an unbound left border of a segment, its value is NegInf.
+func NewLeftUnbound[T any]() Border[T] {
+	return Border[T]{
+		bound: Unbound,
+		value: NegInf[T](),
+	}
+}
+
+// NewRightUnbound creates an unbound right border of a segment, its value is PosInf.
+func NewRightUnbound[T any]() Border[T] {
+	return Border[T]{
+		bound: Unbound,
+		value: PosInf[T](),
+	}
+}
+
+// AsFrom returns a border as a left border of a segment: an unbound border gets NegInf value.
+func (b *Border[T]) AsFrom() Border[T] {
+	if b.IsUnbound() {
+		return NewLeftUnbound[T]()
+	}
+	return *b
+}
+
+// AsTill returns a border as a right border of a segment: an unbound border gets PosInf value.
+func (b *Border[T]) AsTill() Border[T] {
+	if b.IsUnbound() {
+		return NewRightUnbound[T]()
+	}
+	return *b
+}
+
+// LeftBoundTo converts a left border to a given bound: (1 -> [2, [2 -> (1.
+// Unbound borders remain unbound. It returns ErrNotDiscrete for continuous values, because they have no next and prev values.
+func LeftBoundTo[T any](b Border[T], to Bound) (Border[T], error) {
+	if !b.IsUnbound() && !b.IsBound(to) {
+		if IsContinuous(b.Value()) {
+			return b, ErrNotDiscrete
+		}
+		switch to {
+		case Included:
+			value, err := b.Value().Next()
+			if err != nil {
+				return b, err
+			}
+			b = NewBorder(Included, value)
+		case Excluded:
+			value, err := b.Value().Prev()
+			if err != nil {
+				return b, err
+			}
+			b = NewBorder(Excluded, value)
+		case Unbound:
+		}
+	}
+	return b, nil
+}
+
+// RightBoundTo converts a right border to a given bound: 1) -> 0], 0] -> 1).
+// Unbound borders remain unbound. It returns ErrNotDiscrete for continuous values, because they have no next and prev values.
+func RightBoundTo[T any](b Border[T], to Bound) (Border[T], error) {
+	if !b.IsUnbound() && !b.IsBound(to) {
+		if IsContinuous(b.Value()) {
+			return b, ErrNotDiscrete
+		}
+		switch to {
+		case Included:
+			value, err := b.Value().Prev()
+			if err != nil {
+				return b, err
+			}
+			b = NewBorder(Included, value)
+		case Excluded:
+			value, err := b.Value().Next()
+			if err != nil {
+				return b, err
+			}
+			b = NewBorder(Excluded, value)
+		case Unbound:
+		}
+	}
+	return b, nil
+}
@@ -403,3 +403,43 @@ func TestNewUnbound(t *testing.T) {
 		})
 	}
 }
+
+func TestBorder_AsFrom(t *testing.T) {
+	tests := []struct {
+		name string
+		b    Border[int64]
+		want string
+	}{
+		{name: "[1]", b: NewIncluded(NewTestValue(1)), want: "1"},
+		{name: "(inf)", b: NewUnbound[int64](), want: "-inf"},
+		{name: "(+inf)", b: NewRightUnbound[int64](), want: "-inf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.b.AsFrom()
+			if got.String() != tt.want || got.IsBound(Unbound) != tt.b.IsUnbound() {
+				t.Errorf("AsFrom() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestBorder_AsTill(t *testing.T) {
+	tests := []struct {
+		name string
+		b    Border[int64]
+		want string
+	}{
+		{name: "[1]", b: NewIncluded(NewTestValue(1)), want: "1"},
+		{name: "(inf)", b: NewUnbound[int64](), want: "+inf"},
+		{name: "(-inf)", b: NewLeftUnbound[int64](), want: "+inf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.b.AsTill()
+			if got.String() != tt.want || got.IsBound(Unbound) != tt.b.IsUnbound() {
+				t.Errorf("AsTill() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
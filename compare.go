@@ -0,0 +1,92 @@
+package segment
+
+// CompareValues compares two values with a given comparison function, taking into account infinities:
+// NegInf is less than any other value and PosInf is bigger than any other value.
+// Inf has no sign, it is compared as PosInf.
+func CompareValues[T any](a, b Value[T], cmp func(T, T) int) int {
+	aSign, aInf := infSign(a)
+	bSign, bInf := infSign(b)
+	switch {
+	case aInf && bInf:
+		return compareInts(aSign, bSign)
+	case aInf:
+		return aSign
+	case bInf:
+		return -bSign
+	}
+	return cmp(a.Value(), b.Value())
+}
+
+// CompareFrom compares two left borders of segments.
+// An unbound border is less than any other border, and for equal values Included starts before Excluded: [1 < (1
+func CompareFrom[T any](a, b Border[T], cmp func(T, T) int) int {
+	a, b = a.AsFrom(), b.AsFrom()
+	if c := CompareValues(a.value, b.value, cmp); c != 0 || a.bound == b.bound {
+		return c
+	}
+	if a.IsIncluded() {
+		return -1
+	}
+	return 1
+}
+
+// CompareTill compares two right borders of segments.
+// An unbound border is bigger than any other border, and for equal values Excluded ends before Included: 1) < 1]
+func CompareTill[T any](a, b Border[T], cmp func(T, T) int) int {
+	a, b = a.AsTill(), b.AsTill()
+	if c := CompareValues(a.value, b.value, cmp); c != 0 || a.bound == b.bound {
+		return c
+	}
+	if a.IsIncluded() {
+		return 1
+	}
+	return -1
+}
+
+// IncludesFrom returns true if a point is not before a left border: [1 includes 1, (1 does not.
+func IncludesFrom[T any](from Border[T], point T, cmp func(T, T) int) bool {
+	if from.IsUnbound() {
+		return true
+	}
+	c := comparePoint(from.value, point, cmp)
+	return c < 0 || (c == 0 && from.IsIncluded())
+}
+
+// IncludesTill returns true if a point is not after a right border: 1] includes 1, 1) does not.
+func IncludesTill[T any](till Border[T], point T, cmp func(T, T) int) bool {
+	if till.IsUnbound() {
+		return true
+	}
+	c := comparePoint(till.value, point, cmp)
+	return c > 0 || (c == 0 && till.IsIncluded())
+}
+
+// comparePoint compares a value with a point, taking into account infinities.
+func comparePoint[T any](v Value[T], point T, cmp func(T, T) int) int {
+	if sign, ok := infSign(v); ok {
+		return sign
+	}
+	return cmp(v.Value(), point)
+}
+
+// infSign returns a sign of an infinity, Inf is treated as PosInf.
+func infSign[T any](v Value[T]) (int, bool) {
+	if v == nil {
+		return 0, false
+	}
+	sign, ok := InfSign(v)
+	if ok && sign == 0 {
+		sign = 1
+	}
+	return sign, ok
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,106 @@
+package segment
+
+import (
+	"cmp"
+	"testing"
+)
+
+func TestCompareValues(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Value[int64]
+		b    Value[int64]
+		want int
+	}{
+		{name: "1 < 2", a: NewTestValue(1), b: NewTestValue(2), want: -1},
+		{name: "2 = 2", a: NewTestValue(2), b: NewTestValue(2), want: 0},
+		{name: "-inf < 1", a: NegInf[int64](), b: NewTestValue(1), want: -1},
+		{name: "1 < +inf", a: NewTestValue(1), b: PosInf[int64](), want: -1},
+		{name: "+inf > 1", a: PosInf[int64](), b: NewTestValue(1), want: 1},
+		{name: "inf > 1", a: Inf[int64](), b: NewTestValue(1), want: 1},
+		{name: "-inf < +inf", a: NegInf[int64](), b: PosInf[int64](), want: -1},
+		{name: "-inf = -inf", a: NegInf[int64](), b: NegInf[int64](), want: 0},
+		{name: "+inf = inf", a: PosInf[int64](), b: Inf[int64](), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareValues(tt.a, tt.b, cmp.Compare[int64]); got != tt.want {
+				t.Errorf("CompareValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareFrom(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Border[int64]
+		b    Border[int64]
+		want int
+	}{
+		{name: "[1 < [2", a: NewIncluded(NewTestValue(1)), b: NewIncluded(NewTestValue(2)), want: -1},
+		{name: "[1 < (1", a: NewIncluded(NewTestValue(1)), b: NewExcluded(NewTestValue(1)), want: -1},
+		{name: "(1 > [1", a: NewExcluded(NewTestValue(1)), b: NewIncluded(NewTestValue(1)), want: 1},
+		{name: "(1 = (1", a: NewExcluded(NewTestValue(1)), b: NewExcluded(NewTestValue(1)), want: 0},
+		{name: "(inf < [1", a: NewUnbound[int64](), b: NewIncluded(NewTestValue(1)), want: -1},
+		{name: "(-inf < [1", a: NewLeftUnbound[int64](), b: NewIncluded(NewTestValue(1)), want: -1},
+		{name: "(inf = (-inf", a: NewUnbound[int64](), b: NewLeftUnbound[int64](), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareFrom(tt.a, tt.b, cmp.Compare[int64]); got != tt.want {
+				t.Errorf("CompareFrom() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareTill(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Border[int64]
+		b    Border[int64]
+		want int
+	}{
+		{name: "1] < 2]", a: NewIncluded(NewTestValue(1)), b: NewIncluded(NewTestValue(2)), want: -1},
+		{name: "1] > 1)", a: NewIncluded(NewTestValue(1)), b: NewExcluded(NewTestValue(1)), want: 1},
+		{name: "1) < 1]", a: NewExcluded(NewTestValue(1)), b: NewIncluded(NewTestValue(1)), want: -1},
+		{name: "1] = 1]", a: NewIncluded(NewTestValue(1)), b: NewIncluded(NewTestValue(1)), want: 0},
+		{name: "inf) > 1]", a: NewUnbound[int64](), b: NewIncluded(NewTestValue(1)), want: 1},
+		{name: "+inf) > 1]", a: NewRightUnbound[int64](), b: NewIncluded(NewTestValue(1)), want: 1},
+		{name: "inf) = +inf)", a: NewUnbound[int64](), b: NewRightUnbound[int64](), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareTill(tt.a, tt.b, cmp.Compare[int64]); got != tt.want {
+				t.Errorf("CompareTill() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncludesFromTill(t *testing.T) {
+	tests := []struct {
+		name     string
+		b        Border[int64]
+		point    int64
+		wantFrom bool
+		wantTill bool
+	}{
+		{name: "[1 1]: 1", b: NewIncluded(NewTestValue(1)), point: 1, wantFrom: true, wantTill: true},
+		{name: "(1 1): 1", b: NewExcluded(NewTestValue(1)), point: 1, wantFrom: false, wantTill: false},
+		{name: "[1 1]: 0", b: NewIncluded(NewTestValue(1)), point: 0, wantFrom: false, wantTill: true},
+		{name: "[1 1]: 2", b: NewIncluded(NewTestValue(1)), point: 2, wantFrom: true, wantTill: false},
+		{name: "(inf inf): 2", b: NewUnbound[int64](), point: 2, wantFrom: true, wantTill: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IncludesFrom(tt.b, tt.point, cmp.Compare[int64]); got != tt.wantFrom {
+				t.Errorf("IncludesFrom() = %v, want %v", got, tt.wantFrom)
+			}
+			if got := IncludesTill(tt.b, tt.point, cmp.Compare[int64]); got != tt.wantTill {
+				t.Errorf("IncludesTill() = %v, want %v", got, tt.wantTill)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package segment
+
+import "errors"
+
+var ErrNotDiscrete = errors.New("value is not discrete")
+
+// Continuous is a marker interface of values, that have no meaningful next and prev values:
+// strings, rationals, decimals of arbitrary precision. Next and Prev of such values return ErrNotDiscrete.
+//
+// Segments of continuous values can't change bounds of their borders, so inclusion, emptiness and set operations
+// are evaluated only with bounds and comparison of values: (1;1) is empty, but (1;2) is not, even if there is no value between.
+type Continuous interface {
+	Continuous()
+}
+
+// IsContinuous returns true if a value is declared as continuous. Infinities are not continuous.
+func IsContinuous[T any](v Value[T]) bool {
+	_, ok := v.(Continuous)
+	return ok
+}
+
+// IsEmptyBorders returns true if there are no values between borders, comparing only values and bounds:
+// [2;1], (1;1], [1;1) and (1;1) are empty, [1;1] and (1;2) are not.
+func IsEmptyBorders[T any](from, till Border[T], cmp func(T, T) int) bool {
+	from, till = from.AsFrom(), till.AsTill()
+	c := CompareValues(from.value, till.value, cmp)
+	return c > 0 || (c == 0 && !(from.IsIncluded() && till.IsIncluded()))
+}
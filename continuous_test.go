@@ -0,0 +1,52 @@
+package segment
+
+import (
+	"cmp"
+	"testing"
+)
+
+type testContinuous struct {
+	*testValue
+}
+
+func (v testContinuous) Continuous() {}
+
+func TestIsContinuous(t *testing.T) {
+	if IsContinuous(NewTestValue(1)) {
+		t.Errorf("IsContinuous() of a discrete value = true")
+	}
+	if IsContinuous(PosInf[int64]()) {
+		t.Errorf("IsContinuous() of an infinity = true")
+	}
+	var v Value[int64] = testContinuous{&testValue{value: 1}}
+	if !IsContinuous(v) {
+		t.Errorf("IsContinuous() of a continuous value = false")
+	}
+}
+
+func TestIsEmptyBorders(t *testing.T) {
+	one, two := NewTestValue(1), NewTestValue(2)
+	tests := []struct {
+		name string
+		from Border[int64]
+		till Border[int64]
+		want bool
+	}{
+		{name: "[1;1]", from: NewIncluded(one), till: NewIncluded(one), want: false},
+		{name: "[1;1)", from: NewIncluded(one), till: NewExcluded(one), want: true},
+		{name: "(1;1]", from: NewExcluded(one), till: NewIncluded(one), want: true},
+		{name: "(1;1)", from: NewExcluded(one), till: NewExcluded(one), want: true},
+		{name: "(1;2)", from: NewExcluded(one), till: NewExcluded(two), want: false},
+		{name: "[2;1]", from: NewIncluded(two), till: NewIncluded(one), want: true},
+		{name: "(-inf;1)", from: NewUnbound[int64](), till: NewExcluded(one), want: false},
+		{name: "(2;+inf)", from: NewExcluded(two), till: NewUnbound[int64](), want: false},
+		{name: "(-inf;+inf)", from: NewUnbound[int64](), till: NewUnbound[int64](), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsEmptyBorders(tt.from, tt.till, cmp.Compare[int64]); got != tt.want {
+				t.Errorf("IsEmptyBorders() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
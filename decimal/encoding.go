@@ -0,0 +1,61 @@
+package decimal
+
+import (
+	"github.com/pioniro/segment-go"
+)
+
+func init() {
+	segment.RegisterDefaultValue(func(d Decimal) segment.Value[Decimal] { return d }, parseDecimalValue)
+}
+
+// MarshalJSON encodes a segment as an object with decimals as strings: {"from":{"bound":"included","value":"0.00"},...}
+func (s *DecimalSegment) MarshalJSON() ([]byte, error) {
+	return segment.MarshalSegmentJSON[Decimal](s)
+}
+
+// UnmarshalJSON decodes a segment from an object or from a string in interval notation.
+// A scale of a segment is a scale of its borders, so all bound borders must have the same scale.
+func (s *DecimalSegment) UnmarshalJSON(data []byte) error {
+	from, till, err := segment.UnmarshalSegmentJSONRegistered[Decimal](data)
+	if err != nil {
+		return err
+	}
+	return s.set(from, till)
+}
+
+// MarshalText encodes a segment in interval notation, see String.
+func (s *DecimalSegment) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a segment in interval notation: [0.00;99.99], see UnmarshalJSON for a scale.
+func (s *DecimalSegment) UnmarshalText(text []byte) error {
+	from, till, err := segment.ParseBorders(string(text), parseDecimalValue)
+	if err != nil {
+		return err
+	}
+	return s.set(from, till)
+}
+
+func (s *DecimalSegment) set(from, till segment.Border[Decimal]) error {
+	scale := 0
+	if !from.IsUnbound() {
+		scale = from.Value().Value().Scale()
+	} else if !till.IsUnbound() {
+		scale = till.Value().Value().Scale()
+	}
+	seg, err := NewDecimalSegment(scale, from, till)
+	if err != nil {
+		return err
+	}
+	*s = *seg
+	return nil
+}
+
+func parseDecimalValue(s string) (segment.Value[Decimal], error) {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
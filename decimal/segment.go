@@ -0,0 +1,169 @@
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	gen "github.com/pioniro/generator-go"
+	"github.com/pioniro/segment-go"
+	"iter"
+	"math"
+)
+
+var ErrScaleMismatch = errors.New("decimal: scale of a border does not match a scale of a segment")
+
+// DecimalSegment is an implementation of ISegment, TryToSegment, IncludedSegment, IterableSegment interfaces for decimals
+// of a fixed scale. Decimals are discrete: [0.00;100.00) == [0.00;99.99].
+type DecimalSegment struct {
+	scale int
+	from  segment.Border[Decimal]
+	till  segment.Border[Decimal]
+}
+
+// NewDecimalSegment creates a new segment of decimals with a given scale.
+// It returns ErrScaleMismatch if a border has other scale: [0.0;99.99] is rejected, and ErrInvalidScale for an invalid scale.
+func NewDecimalSegment(scale int, from, till segment.Border[Decimal]) (*DecimalSegment, error) {
+	if scale < 0 || scale > MaxScale {
+		return nil, ErrInvalidScale
+	}
+	for _, b := range []segment.Border[Decimal]{from, till} {
+		if !b.IsUnbound() && b.Value().Value().Scale() != scale {
+			return nil, fmt.Errorf("%w: %v has scale %d, want %d", ErrScaleMismatch, b.Value(), b.Value().Value().Scale(), scale)
+		}
+	}
+	return &DecimalSegment{
+		scale: scale,
+		from:  from.AsFrom(),
+		till:  till.AsTill(),
+	}, nil
+}
+
+// MustNewDecimalSegment is like NewDecimalSegment, but panics in case of an error.
+func MustNewDecimalSegment(scale int, from, till segment.Border[Decimal]) *DecimalSegment {
+	s, err := NewDecimalSegment(scale, from, till)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Scale returns a scale of all decimals of a segment.
+func (s *DecimalSegment) Scale() int {
+	return s.scale
+}
+
+func (s *DecimalSegment) From() *segment.Border[Decimal] {
+	return &s.from
+}
+
+func (s *DecimalSegment) Till() *segment.Border[Decimal] {
+	return &s.till
+}
+
+// TryTo tries to create a new segment from a given segment, but with different borders if it is possible.
+// Bounds are converted by one unit of the last digit: (0.00;100.00) -> [0.01;99.99].
+// It returns ErrHasNoNextValue or ErrHasNoPrevValue if units overflow int64.
+func (s *DecimalSegment) TryTo(from segment.Bound, till segment.Bound) (segment.TryToSegment[Decimal], error) {
+	f, err := segment.LeftBoundTo(s.from, from)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := segment.RightBoundTo(s.till, till)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DecimalSegment{scale: s.scale, from: f, till: t}, nil
+}
+
+// String returns a string representation of a segment with exactly scale fractional digits.
+// example: [0.00;99.99], [100.00;+inf)
+func (s *DecimalSegment) String() string {
+	return segment.Format[Decimal](s)
+}
+
+func (s *DecimalSegment) IsEmpty() bool {
+	from, till, ok := s.canonical()
+	return !ok || segment.IsEmptyBorders(from, till, compareDecimal)
+}
+
+// IsIncludes returns true if a segment includes a point. A point is compared by its number, so it can have any scale,
+// but a point with a bigger scale can fall between segments: 99.995 is neither in [0.00;99.99] nor in [100.00;+inf).
+func (s *DecimalSegment) IsIncludes(point Decimal) bool {
+	return segment.IncludesFrom(s.from, point, compareDecimal) && segment.IncludesTill(s.till, point, compareDecimal)
+}
+
+// Size returns a number of decimals in a segment: Size( [0.00;1.00) ) == 100.
+// If a segment is unbound or the number overflows int64, then ErrSegmentTooBig will be returned.
+func (s *DecimalSegment) Size() (int64, error) {
+	if s.IsEmpty() {
+		return 0, nil
+	}
+	if s.from.IsUnbound() || s.till.IsUnbound() {
+		return 0, segment.ErrSegmentTooBig
+	}
+	from, till, _ := s.canonical()
+	f, t := from.Value().Value().Units(), till.Value().Value().Units()
+	// t >= f, so t - f + 1 overflows only if f is not positive and t - f >= MaxInt64
+	if f <= 0 && t >= math.MaxInt64+f {
+		return 0, segment.ErrSegmentTooBig
+	}
+	return t - f + 1, nil
+}
+
+// Iterate returns a generator of all decimals of a segment: [0.98;1.00] -> 0.98, 0.99, 1.00.
+// If from is unbound, then empty gen will be returned. If till is unbound, then gen ends at the maximal decimal.
+func (s *DecimalSegment) Iterate() gen.Generator[Decimal] {
+	return segment.FromSeq(s.All())
+}
+
+// All returns an iterator over all decimals of a segment in ascending order, see Iterate.
+func (s *DecimalSegment) All() iter.Seq[Decimal] {
+	return func(yield func(Decimal) bool) {
+		if s.from.IsUnbound() || s.IsEmpty() {
+			return
+		}
+		from, till, _ := s.canonical()
+		last := int64(math.MaxInt64)
+		if !till.IsUnbound() {
+			last = till.Value().Value().Units()
+		}
+		// i == last is checked before i++, so i never overflows
+		for i := from.Value().Value().Units(); ; i++ {
+			if !yield(Decimal{units: i, scale: s.scale}) || i == last {
+				return
+			}
+		}
+	}
+}
+
+// ParseSegment parses a segment in interval notation with decimals of a given scale: [0;99.99] -> [0.00;99.99].
+// See Parse for errors of values.
+func ParseSegment(s string, scale int) (*DecimalSegment, error) {
+	from, till, err := segment.ParseBorders(s, func(s string) (segment.Value[Decimal], error) {
+		return Parse(s, scale)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return NewDecimalSegment(scale, from, till)
+}
+
+// MustParseSegment is like ParseSegment, but panics if a string cannot be parsed.
+func MustParseSegment(s string, scale int) *DecimalSegment {
+	seg, err := ParseSegment(s, scale)
+	if err != nil {
+		panic(err)
+	}
+	return seg
+}
+
+// canonical returns borders of a segment converted to included ones: (0.00;1.00) -> [0.01;0.99]
+// If units overflow, then a segment is empty and false will be returned: (MaxInt64 units;+inf)
+func (s *DecimalSegment) canonical() (segment.Border[Decimal], segment.Border[Decimal], bool) {
+	inc, err := s.TryTo(segment.Included, segment.Included)
+	if err != nil {
+		return s.from, s.till, false
+	}
+	return *inc.From(), *inc.Till(), true
+}
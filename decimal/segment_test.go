@@ -0,0 +1,119 @@
+package decimal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/pioniro/segment-go"
+	"math"
+	"testing"
+)
+
+func TestNewDecimalSegment(t *testing.T) {
+	_, err := NewDecimalSegment(2, segment.NewIncluded(New(0, 1)), segment.NewIncluded(New(9999, 2)))
+	if !errors.Is(err, ErrScaleMismatch) {
+		t.Errorf("NewDecimalSegment() error = %v, want ErrScaleMismatch", err)
+	}
+	if _, err := NewDecimalSegment(19, segment.NewUnbound[Decimal](), segment.NewUnbound[Decimal]()); !errors.Is(err, ErrInvalidScale) {
+		t.Errorf("NewDecimalSegment() error = %v, want ErrInvalidScale", err)
+	}
+	s := MustParseSegment("[0;99.99]", 2)
+	if s.String() != "[0.00;99.99]" || s.Scale() != 2 {
+		t.Errorf("MustParseSegment() = %v", s)
+	}
+	if _, err := ParseSegment("[0;99.999]", 2); !errors.Is(err, ErrInexact) {
+		t.Errorf("ParseSegment() error = %v, want ErrInexact", err)
+	}
+}
+
+func TestDecimalSegment_IsIncludes(t *testing.T) {
+	low, high := MustParseSegment("[0.00;99.99]", 2), MustParseSegment("[100.00;+inf)", 2)
+	tests := []struct {
+		point     string
+		low, high bool
+	}{
+		{point: "0", low: true},
+		{point: "99.99", low: true},
+		{point: "99.995"},
+		{point: "100", high: true},
+		{point: "-0.01"},
+	}
+	for _, tt := range tests {
+		d, _ := ParseDecimal(tt.point)
+		if low.IsIncludes(d) != tt.low || high.IsIncludes(d) != tt.high {
+			t.Errorf("IsIncludes(%s) = %v, %v, want %v, %v", tt.point, low.IsIncludes(d), high.IsIncludes(d), tt.low, tt.high)
+		}
+	}
+}
+
+func TestDecimalSegment_Size(t *testing.T) {
+	tests := map[string]int64{
+		"[0.00;1.00)": 100,
+		"(0.00;0.01)": 0,
+		"[1.00;0.99]": 0,
+	}
+	for input, want := range tests {
+		got, err := MustParseSegment(input, 2).Size()
+		if err != nil || got != want {
+			t.Errorf("Size(%s) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+	overflow := []*DecimalSegment{
+		MustParseSegment("[0;+inf)", 2),
+		MustNewDecimalSegment(0, segment.NewIncluded(New(0, 0)), segment.NewIncluded(New(math.MaxInt64, 0))),
+		MustNewDecimalSegment(0, segment.NewIncluded(New(math.MinInt64, 0)), segment.NewIncluded(New(-1, 0))),
+	}
+	for _, s := range overflow {
+		if _, err := s.Size(); !errors.Is(err, segment.ErrSegmentTooBig) {
+			t.Errorf("Size(%v) error = %v, want ErrSegmentTooBig", s, err)
+		}
+	}
+	size, err := MustNewDecimalSegment(0, segment.NewIncluded(New(math.MinInt64, 0)), segment.NewIncluded(New(-2, 0))).Size()
+	if err != nil || size != math.MaxInt64 {
+		t.Errorf("Size() = %v, %v, want MaxInt64", size, err)
+	}
+	empty := MustNewDecimalSegment(2, segment.NewExcluded(New(math.MaxInt64, 2)), segment.NewUnbound[Decimal]())
+	if !empty.IsEmpty() {
+		t.Errorf("IsEmpty(%v) = false", empty)
+	}
+}
+
+func TestDecimalSegment_All(t *testing.T) {
+	var got []Decimal
+	for d := range MustParseSegment("(0.97;1.00]", 2).All() {
+		got = append(got, d)
+	}
+	if fmt.Sprint(got) != "[0.98 0.99 1.00]" {
+		t.Errorf("All() = %v", got)
+	}
+	got = nil
+	MustNewDecimalSegment(1, segment.NewIncluded(New(math.MaxInt64-1, 1)), segment.NewUnbound[Decimal]()).Iterate()(func(d Decimal, err error) bool {
+		got = append(got, d)
+		return err == nil
+	})
+	if len(got) != 2 {
+		t.Errorf("Iterate() = %v, want 2 values up to the maximal decimal", got)
+	}
+}
+
+func TestDecimalSegment_JSON(t *testing.T) {
+	s := MustParseSegment("[100.00;+inf)", 2)
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"from":{"bound":"included","value":"100.00"},"till":{"bound":"unbound"}}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	for _, input := range []string{want, `"[100.00;+inf)"`} {
+		var got DecimalSegment
+		if err := json.Unmarshal([]byte(input), &got); err != nil || got.String() != s.String() || got.Scale() != 2 {
+			t.Errorf("Unmarshal(%s) = %v, %v", input, &got, err)
+		}
+	}
+	var got DecimalSegment
+	if err := json.Unmarshal([]byte(`"[0.0;99.99]"`), &got); !errors.Is(err, ErrScaleMismatch) {
+		t.Errorf("Unmarshal() error = %v, want ErrScaleMismatch", err)
+	}
+}
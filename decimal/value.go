@@ -0,0 +1,205 @@
+// Package decimal provides fixed-point decimal values and segments of them, for example price tiers: [0.00;99.99], [100.00;+inf).
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"github.com/pioniro/segment-go"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// MaxScale is the maximal number of fractional digits of a decimal.
+const MaxScale = 18
+
+var (
+	ErrInvalidScale = errors.New("decimal: scale must be from 0 to 18")
+	ErrInexact      = errors.New("decimal: value can't be represented exactly with a scale")
+)
+
+// pow10 holds powers of ten up to 10^MaxScale, all of them fit into int64.
+var pow10 = func() [MaxScale + 1]int64 {
+	var p [MaxScale + 1]int64
+	p[0] = 1
+	for i := 1; i <= MaxScale; i++ {
+		p[i] = p[i-1] * 10
+	}
+	return p
+}()
+
+// Decimal is a fixed-point decimal number units * 10^-scale: 99.99 is 9999 units with scale 2.
+// It implements segment.Value itself: Next and Prev move it by one unit of the last digit, 99.99 -> 100.00.
+// Decimals with different scales are different values even if they are equal numbers, use Compare to compare them.
+type Decimal struct {
+	units int64
+	scale int
+}
+
+// New returns a decimal units * 10^-scale: New(9999, 2) is 99.99. It panics if a scale is out of [0; MaxScale].
+func New(units int64, scale int) Decimal {
+	if scale < 0 || scale > MaxScale {
+		panic(ErrInvalidScale)
+	}
+	return Decimal{units: units, scale: scale}
+}
+
+// Parse parses a decimal and converts it to a given scale: Parse("99.9", 2) is 99.90.
+// It returns ErrInexact if a value has more significant fractional digits than a scale: Parse("99.999", 2).
+func Parse(s string, scale int) (Decimal, error) {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return d.Rescale(scale)
+}
+
+// ParseDecimal parses a decimal with a scale equal to a number of its fractional digits: 99.90 has scale 2, 100 has scale 0.
+func ParseDecimal(s string) (Decimal, error) {
+	fail := func(err error) (Decimal, error) {
+		return Decimal{}, &strconv.NumError{Func: "ParseDecimal", Num: s, Err: err}
+	}
+	rest := s
+	negative := false
+	if rest != "" && (rest[0] == '+' || rest[0] == '-') {
+		negative = rest[0] == '-'
+		rest = rest[1:]
+	}
+	integer, fraction, _ := strings.Cut(rest, ".")
+	if integer == "" || (fraction == "" && strings.HasSuffix(rest, ".")) || !isDigits(integer) || !isDigits(fraction) {
+		return fail(strconv.ErrSyntax)
+	}
+	if len(fraction) > MaxScale {
+		return fail(ErrInvalidScale)
+	}
+	// magnitude of math.MinInt64 is bigger than math.MaxInt64 by one
+	units, err := strconv.ParseUint(integer+fraction, 10, 64)
+	if err != nil || units > math.MaxInt64+1 || (units == math.MaxInt64+1 && !negative) {
+		return fail(strconv.ErrRange)
+	}
+	if negative {
+		return Decimal{units: -int64(units), scale: len(fraction)}, nil
+	}
+	return Decimal{units: int64(units), scale: len(fraction)}, nil
+}
+
+// Units returns a number of units of the last digit: 9999 for 99.99.
+func (d Decimal) Units() int64 {
+	return d.units
+}
+
+// Scale returns a number of fractional digits: 2 for 99.99.
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+// Rescale converts a decimal to a given scale: 99.9 -> 99.90 -> 99.9.
+// It returns ErrInexact if significant digits would be lost (99.99 -> 100.0) or a value overflows int64 units.
+func (d Decimal) Rescale(scale int) (Decimal, error) {
+	if scale < 0 || scale > MaxScale {
+		return Decimal{}, ErrInvalidScale
+	}
+	if scale >= d.scale {
+		m := pow10[scale-d.scale]
+		if d.units > math.MaxInt64/m || d.units < math.MinInt64/m {
+			return Decimal{}, fmt.Errorf("%w: %v overflows with scale %d", ErrInexact, d, scale)
+		}
+		return Decimal{units: d.units * m, scale: scale}, nil
+	}
+	div := pow10[d.scale-scale]
+	if d.units%div != 0 {
+		return Decimal{}, fmt.Errorf("%w: %v with scale %d", ErrInexact, d, scale)
+	}
+	return Decimal{units: d.units / div, scale: scale}, nil
+}
+
+// Compare compares numbers of decimals regardless of their scales: 1.5 == 1.50 < 1.51.
+func (d Decimal) Compare(o Decimal) int {
+	if d.scale == o.scale {
+		return compareInt64(d.units, o.units)
+	}
+	return d.Rat().Cmp(o.Rat())
+}
+
+// Rat returns an exact rational number of a decimal.
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(d.units), big.NewInt(pow10[d.scale]))
+}
+
+// String returns a decimal with exactly scale fractional digits: 100.00, -0.05, 7.
+func (d Decimal) String() string {
+	// uint64 holds a magnitude of math.MinInt64
+	abs := uint64(d.units)
+	sign := ""
+	if d.units < 0 {
+		abs = -abs
+		sign = "-"
+	}
+	digits := strconv.FormatUint(abs, 10)
+	if d.scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= d.scale {
+		digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+}
+
+// Next returns a decimal bigger by one unit of the last digit: 99.99 -> 100.00. Or ErrHasNoNextValue on overflow.
+func (d Decimal) Next() (segment.Value[Decimal], error) {
+	if d.units == math.MaxInt64 {
+		return d, segment.ErrHasNoNextValue
+	}
+	return Decimal{units: d.units + 1, scale: d.scale}, nil
+}
+
+// Prev returns a decimal smaller by one unit of the last digit: 100.00 -> 99.99. Or ErrHasNoPrevValue on overflow.
+func (d Decimal) Prev() (segment.Value[Decimal], error) {
+	if d.units == math.MinInt64 {
+		return d, segment.ErrHasNoPrevValue
+	}
+	return Decimal{units: d.units - 1, scale: d.scale}, nil
+}
+
+func (d Decimal) Value() Decimal {
+	return d
+}
+
+// MarshalText encodes a decimal as String does, so in JSON it is a string: "99.90".
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decodes a decimal with a scale equal to a number of its fractional digits, see ParseDecimal.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareDecimal(a, b Decimal) int {
+	return a.Compare(b)
+}
@@ -0,0 +1,136 @@
+package decimal
+
+import (
+	"errors"
+	"github.com/pioniro/segment-go"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		input  string
+		units  int64
+		scale  int
+		output string
+	}{
+		{input: "99.99", units: 9999, scale: 2, output: "99.99"},
+		{input: "100.00", units: 10000, scale: 2, output: "100.00"},
+		{input: "-0.05", units: -5, scale: 2, output: "-0.05"},
+		{input: "+7", units: 7, scale: 0, output: "7"},
+		{input: "0.000", units: 0, scale: 3, output: "0.000"},
+		{input: "-9223372036854775808", units: math.MinInt64, scale: 0, output: "-9223372036854775808"},
+		{input: "-922337203.6854775808", units: math.MinInt64, scale: 10, output: "-922337203.6854775808"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := ParseDecimal(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDecimal() error = %v", err)
+			}
+			if d.Units() != tt.units || d.Scale() != tt.scale || d.String() != tt.output {
+				t.Errorf("ParseDecimal() = %v (%d, %d), want %v (%d, %d)", d, d.Units(), d.Scale(), tt.output, tt.units, tt.scale)
+			}
+		})
+	}
+}
+
+func TestParseDecimal_Errors(t *testing.T) {
+	tests := map[string]error{
+		"":                      strconv.ErrSyntax,
+		"1.":                    strconv.ErrSyntax,
+		".5":                    strconv.ErrSyntax,
+		"1.2.3":                 strconv.ErrSyntax,
+		"1e3":                   strconv.ErrSyntax,
+		"--1":                   strconv.ErrSyntax,
+		"9223372036854775808":   strconv.ErrRange,
+		"0.1234567890123456789": ErrInvalidScale,
+	}
+	for input, want := range tests {
+		if _, err := ParseDecimal(input); !errors.Is(err, want) {
+			t.Errorf("ParseDecimal(%q) error = %v, want %v", input, err, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := map[string]string{
+		"99.9":   "99.90",
+		"100":    "100.00",
+		"99.990": "99.99",
+	}
+	for input, want := range tests {
+		d, err := Parse(input, 2)
+		if err != nil || d.String() != want || d.Scale() != 2 {
+			t.Errorf("Parse(%q, 2) = %v, %v, want %v", input, d, err, want)
+		}
+	}
+	if _, err := Parse("99.999", 2); !errors.Is(err, ErrInexact) {
+		t.Errorf("Parse() error = %v, want ErrInexact", err)
+	}
+}
+
+func TestDecimal_Rescale(t *testing.T) {
+	d := New(9990, 2)
+	up, err := d.Rescale(4)
+	if err != nil || up.String() != "99.9000" {
+		t.Errorf("Rescale(4) = %v, %v", up, err)
+	}
+	down, err := d.Rescale(1)
+	if err != nil || down.String() != "99.9" {
+		t.Errorf("Rescale(1) = %v, %v", down, err)
+	}
+	if _, err := d.Rescale(0); !errors.Is(err, ErrInexact) {
+		t.Errorf("Rescale(0) error = %v, want ErrInexact", err)
+	}
+	if _, err := New(math.MaxInt64/10+1, 0).Rescale(1); !errors.Is(err, ErrInexact) {
+		t.Errorf("Rescale() error = %v, want ErrInexact on overflow", err)
+	}
+	if _, err := d.Rescale(MaxScale + 1); !errors.Is(err, ErrInvalidScale) {
+		t.Errorf("Rescale() error = %v, want ErrInvalidScale", err)
+	}
+}
+
+func TestDecimal_Compare(t *testing.T) {
+	tests := []struct {
+		a, b Decimal
+		want int
+	}{
+		{a: New(15, 1), b: New(150, 2), want: 0},
+		{a: New(150, 2), b: New(151, 2), want: -1},
+		{a: New(-1, 0), b: New(-99, 2), want: -1},
+		{a: New(math.MaxInt64, 0), b: New(math.MaxInt64, 18), want: 1},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("Compare(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_NextPrev(t *testing.T) {
+	next, err := New(9999, 2).Next()
+	if err != nil || next.String() != "100.00" {
+		t.Errorf("Next() = %v, %v", next, err)
+	}
+	prev, err := New(0, 3).Prev()
+	if err != nil || prev.String() != "-0.001" {
+		t.Errorf("Prev() = %v, %v", prev, err)
+	}
+	if _, err := New(math.MaxInt64, 2).Next(); !errors.Is(err, segment.ErrHasNoNextValue) {
+		t.Errorf("Next() error = %v, want ErrHasNoNextValue", err)
+	}
+	if _, err := New(math.MinInt64, 2).Prev(); !errors.Is(err, segment.ErrHasNoPrevValue) {
+		t.Errorf("Prev() error = %v, want ErrHasNoPrevValue", err)
+	}
+}
+
+func TestNew_InvalidScale(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("New() did not panic")
+		}
+	}()
+	New(1, -1)
+}
@@ -0,0 +1,270 @@
+package segment
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrNoValueFactory = errors.New("no value factory is registered for a type")
+	ErrInvalidBound   = errors.New("invalid bound")
+)
+
+// ValueFactory creates a value from a raw value, for example integers.Int.
+type ValueFactory[T any] func(T) Value[T]
+
+type valueCodec[T any] struct {
+	factory ValueFactory[T]
+	parse   ValueParser[T]
+	// explicit is false for defaults of packages, see RegisterDefaultValue
+	explicit bool
+}
+
+var (
+	// valueCodecs is a registry of value factories and parsers, a key is a reflect.Type of T.
+	valueCodecs sync.Map
+	// registerMu serializes registrations, that check and replace a registered codec.
+	registerMu sync.Mutex
+)
+
+// RegisterValue registers a factory and a parser of values of type T, that are used to unmarshal borders and segments.
+// If parse is nil, then a value is parsed as JSON, or as a raw string if it is not valid JSON.
+// It replaces a default of a package, see RegisterDefaultValue, so a program can plug its own factory of int64
+// after importing integers. A type can be registered only once: RegisterValue panics if T is already registered
+// with RegisterValue, as sql.Register does.
+func RegisterValue[T any](factory ValueFactory[T], parse ValueParser[T]) {
+	registerMu.Lock()
+	defer registerMu.Unlock()
+	if codec, ok := valueCodecs.Load(typeOf[T]()); ok && codec.(valueCodec[T]).explicit {
+		panic(fmt.Sprintf("segment: RegisterValue called twice for %v", typeOf[T]()))
+	}
+	valueCodecs.Store(typeOf[T](), newValueCodec(factory, parse, true))
+}
+
+// RegisterDefaultValue is like RegisterValue, but registers a default, that packages register for their value types
+// in init: integers for int types, timeseg for time.Time and Date. It does nothing if T is already registered,
+// and it is replaced by a later RegisterValue.
+func RegisterDefaultValue[T any](factory ValueFactory[T], parse ValueParser[T]) {
+	registerMu.Lock()
+	defer registerMu.Unlock()
+	if _, ok := valueCodecs.Load(typeOf[T]()); ok {
+		return
+	}
+	valueCodecs.Store(typeOf[T](), newValueCodec(factory, parse, false))
+}
+
+func newValueCodec[T any](factory ValueFactory[T], parse ValueParser[T], explicit bool) valueCodec[T] {
+	if parse == nil {
+		parse = func(s string) (Value[T], error) {
+			var v T
+			if err := json.Unmarshal([]byte(s), &v); err != nil {
+				if err := json.Unmarshal([]byte(strconv.Quote(s)), &v); err != nil {
+					return nil, err
+				}
+			}
+			return factory(v), nil
+		}
+	}
+	return valueCodec[T]{factory: factory, parse: parse, explicit: explicit}
+}
+
+// LookupValue returns a factory and a parser of values of type T, that were registered with RegisterValue.
+func LookupValue[T any]() (ValueFactory[T], ValueParser[T], bool) {
+	codec, ok := valueCodecs.Load(typeOf[T]())
+	if !ok {
+		return nil, nil, false
+	}
+	c := codec.(valueCodec[T])
+	return c.factory, c.parse, true
+}
+
+func lookupValue[T any]() (ValueFactory[T], ValueParser[T], error) {
+	factory, parse, ok := LookupValue[T]()
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %v", ErrNoValueFactory, typeOf[T]())
+	}
+	return factory, parse, nil
+}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+var boundNames = map[Bound]string{
+	Unbound:  "unbound",
+	Included: "included",
+	Excluded: "excluded",
+}
+
+func (b Bound) String() string {
+	if name, ok := boundNames[b]; ok {
+		return name
+	}
+	return "bound(" + strconv.Itoa(int(b)) + ")"
+}
+
+// MarshalText encodes a bound as "included", "excluded" or "unbound".
+func (b Bound) MarshalText() ([]byte, error) {
+	if _, ok := boundNames[b]; !ok {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidBound, int(b))
+	}
+	return []byte(b.String()), nil
+}
+
+func (b *Bound) UnmarshalText(text []byte) error {
+	for bound, name := range boundNames {
+		if strings.EqualFold(name, string(text)) {
+			*b = bound
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrInvalidBound, text)
+}
+
+type borderJSON struct {
+	Bound Bound           `json:"bound"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type segmentJSON struct {
+	From borderJSON `json:"from"`
+	Till borderJSON `json:"till"`
+}
+
+func toBorderJSON[T any](b Border[T]) (borderJSON, error) {
+	if b.IsUnbound() {
+		return borderJSON{Bound: Unbound}, nil
+	}
+	value, err := json.Marshal(b.value.Value())
+	if err != nil {
+		return borderJSON{}, err
+	}
+	return borderJSON{Bound: b.bound, Value: value}, nil
+}
+
+func fromBorderJSON[T any](b borderJSON, factory ValueFactory[T]) (Border[T], error) {
+	if b.Bound == Unbound {
+		return NewUnbound[T](), nil
+	}
+	if len(b.Value) == 0 {
+		return Border[T]{}, fmt.Errorf("segment: %v border has no value", b.Bound)
+	}
+	var v T
+	if err := json.Unmarshal(b.Value, &v); err != nil {
+		return Border[T]{}, err
+	}
+	return NewBorder(b.Bound, factory(v)), nil
+}
+
+// MarshalJSON encodes a border as an object: {"bound":"included","value":1} or {"bound":"unbound"}.
+func (b Border[T]) MarshalJSON() ([]byte, error) {
+	obj, err := toBorderJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// UnmarshalJSON decodes a border from an object, a value is created with a factory registered with RegisterValue.
+func (b *Border[T]) UnmarshalJSON(data []byte) error {
+	factory, _, err := lookupValue[T]()
+	if err != nil {
+		return err
+	}
+	var obj borderJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	border, err := fromBorderJSON(obj, factory)
+	if err != nil {
+		return err
+	}
+	*b = border
+	return nil
+}
+
+// MarshalText encodes a border as [1] for Included, (1) for Excluded and (inf) for Unbound.
+// A value is quoted as in Format, if it can't be parsed back as is: ["a;b"].
+func (b Border[T]) MarshalText() ([]byte, error) {
+	if b.IsIncluded() {
+		return []byte("[" + formatBorder(b) + "]"), nil
+	}
+	return []byte("(" + formatBorder(b) + ")"), nil
+}
+
+// UnmarshalText decodes a border from [1], (1) or (inf), a value is parsed with a parser registered with RegisterValue.
+func (b *Border[T]) UnmarshalText(text []byte) error {
+	_, parse, err := lookupValue[T]()
+	if err != nil {
+		return err
+	}
+	s := string(bytes.TrimSpace(text))
+	if len(s) < 2 || !((s[0] == '[' && s[len(s)-1] == ']') || (s[0] == '(' && s[len(s)-1] == ')')) {
+		return &ParseError{Input: string(text), Pos: 0, Msg: "expected [value] or (value)"}
+	}
+	bound := Excluded
+	if s[0] == '[' {
+		bound = Included
+	}
+	border, pos, err := parseBorder(s, 1, len(s)-1, bound, 0, parse)
+	if err != nil {
+		return &ParseError{Input: s, Pos: pos, Msg: "invalid border", Err: err}
+	}
+	*b = border
+	return nil
+}
+
+// MarshalSegmentJSON encodes a segment as an object: {"from":{"bound":"included","value":1},"till":{"bound":"unbound"}}.
+func MarshalSegmentJSON[T any](s ISegment[T]) ([]byte, error) {
+	from, err := toBorderJSON(*s.From())
+	if err != nil {
+		return nil, err
+	}
+	till, err := toBorderJSON(*s.Till())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(segmentJSON{From: from, Till: till})
+}
+
+// UnmarshalSegmentJSON decodes borders of a segment from an object (see MarshalSegmentJSON)
+// or from a string in interval notation: "[1;2)".
+func UnmarshalSegmentJSON[T any](data []byte, factory ValueFactory[T], parse ValueParser[T]) (Border[T], Border[T], error) {
+	var from, till Border[T]
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return from, till, err
+		}
+		return ParseBorders(s, parse)
+	}
+	var obj segmentJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return from, till, err
+	}
+	from, err := fromBorderJSON(obj.From, factory)
+	if err != nil {
+		return from, till, err
+	}
+	till, err = fromBorderJSON(obj.Till, factory)
+	if err != nil {
+		return from, till, err
+	}
+	return from.AsFrom(), till.AsTill(), nil
+}
+
+// UnmarshalSegmentJSONRegistered is like UnmarshalSegmentJSON, but uses a factory and a parser registered with RegisterValue.
+func UnmarshalSegmentJSONRegistered[T any](data []byte) (Border[T], Border[T], error) {
+	factory, parse, err := lookupValue[T]()
+	if err != nil {
+		return Border[T]{}, Border[T]{}, err
+	}
+	return UnmarshalSegmentJSON(data, factory, parse)
+}
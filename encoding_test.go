@@ -0,0 +1,188 @@
+package segment
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type testString string
+
+// registerValue registers a factory and a parser of T for a test, the registration is removed after the test.
+func registerValue[T any](t *testing.T, factory ValueFactory[T], parse ValueParser[T]) {
+	t.Helper()
+	RegisterValue(factory, parse)
+	t.Cleanup(func() { valueCodecs.Delete(typeOf[T]()) })
+}
+
+func TestBound_MarshalText(t *testing.T) {
+	for _, b := range []Bound{Unbound, Included, Excluded} {
+		text, err := b.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%v) error = %v", b, err)
+		}
+		var got Bound
+		if err := got.UnmarshalText(text); err != nil || got != b {
+			t.Errorf("UnmarshalText(%s) = %v, %v, want %v", text, got, err, b)
+		}
+	}
+	if _, err := Bound(42).MarshalText(); !errors.Is(err, ErrInvalidBound) {
+		t.Errorf("MarshalText(42) error = %v, want ErrInvalidBound", err)
+	}
+	var b Bound
+	if err := b.UnmarshalText([]byte("open")); !errors.Is(err, ErrInvalidBound) {
+		t.Errorf("UnmarshalText(open) error = %v, want ErrInvalidBound", err)
+	}
+}
+
+func TestBorder_MarshalJSON(t *testing.T) {
+	registerValue(t, NewTestValue, parseTestValue)
+	tests := []struct {
+		border Border[int64]
+		want   string
+	}{
+		{border: NewIncluded(NewTestValue(1)), want: `{"bound":"included","value":1}`},
+		{border: NewExcluded(NewTestValue(-5)), want: `{"bound":"excluded","value":-5}`},
+		{border: NewUnbound[int64](), want: `{"bound":"unbound"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			data, err := json.Marshal(tt.border)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", data, tt.want)
+			}
+			var got Border[int64]
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if got.bound != tt.border.bound || got.String() != tt.border.String() {
+				t.Errorf("Unmarshal() = %v %s, want %v %s", got.bound, got.String(), tt.border.bound, tt.border.String())
+			}
+		})
+	}
+	var b Border[int64]
+	if err := json.Unmarshal([]byte(`{"bound":"included"}`), &b); err == nil {
+		t.Errorf("Unmarshal() of a border without a value error = nil")
+	}
+}
+
+func TestBorder_MarshalText(t *testing.T) {
+	registerValue(t, NewTestValue, parseTestValue)
+	tests := []struct {
+		border Border[int64]
+		want   string
+	}{
+		{border: NewIncluded(NewTestValue(1)), want: "[1]"},
+		{border: NewExcluded(NewTestValue(1)), want: "(1)"},
+		{border: NewUnbound[int64](), want: "(inf)"},
+		{border: NewLeftUnbound[int64](), want: "(-inf)"},
+		{border: NewRightUnbound[int64](), want: "(+inf)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			text, err := tt.border.MarshalText()
+			if err != nil || string(text) != tt.want {
+				t.Fatalf("MarshalText() = %s, %v, want %s", text, err, tt.want)
+			}
+			var got Border[int64]
+			if err := got.UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText() error = %v", err)
+			}
+			if got.bound != tt.border.bound || got.String() != tt.border.String() {
+				t.Errorf("UnmarshalText() = %v %s, want %v %s", got.bound, got.String(), tt.border.bound, tt.border.String())
+			}
+		})
+	}
+	var b Border[int64]
+	for _, input := range []string{"", "1", "[1)", "[x]", "[]"} {
+		if err := b.UnmarshalText([]byte(input)); err == nil {
+			t.Errorf("UnmarshalText(%q) error = nil", input)
+		}
+	}
+}
+
+func TestUnmarshalSegmentJSON(t *testing.T) {
+	tests := []struct {
+		input    string
+		wantFrom string
+		wantTill string
+	}{
+		{input: `{"from":{"bound":"included","value":1},"till":{"bound":"unbound"}}`, wantFrom: "[1", wantTill: "+inf)"},
+		{input: `{"from":{"bound":"unbound"},"till":{"bound":"excluded","value":5}}`, wantFrom: "(-inf", wantTill: "5)"},
+		{input: ` "(1;2]" `, wantFrom: "(1", wantTill: "2]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			from, till, err := UnmarshalSegmentJSON([]byte(tt.input), NewTestValue, parseTestValue)
+			if err != nil {
+				t.Fatalf("UnmarshalSegmentJSON() error = %v", err)
+			}
+			gotFrom, _ := from.MarshalText()
+			gotTill, _ := till.MarshalText()
+			if string(gotFrom[:len(gotFrom)-1]) != tt.wantFrom || string(gotTill[1:]) != tt.wantTill {
+				t.Errorf("UnmarshalSegmentJSON() = %s %s, want %s %s", gotFrom, gotTill, tt.wantFrom, tt.wantTill)
+			}
+		})
+	}
+	for _, input := range []string{`"[1;"`, `{"from":{"bound":"open"}}`, `[1]`} {
+		if _, _, err := UnmarshalSegmentJSON([]byte(input), NewTestValue, parseTestValue); err == nil {
+			t.Errorf("UnmarshalSegmentJSON(%s) error = nil", input)
+		}
+	}
+}
+
+func TestLookupValue(t *testing.T) {
+	if _, _, err := UnmarshalSegmentJSONRegistered[complex64]([]byte(`"[1;2]"`)); !errors.Is(err, ErrNoValueFactory) {
+		t.Errorf("UnmarshalSegmentJSON() of unregistered type error = %v, want ErrNoValueFactory", err)
+	}
+	registerValue(t, func(v testString) Value[testString] { return &testName[testString]{v} }, nil)
+	_, parse, ok := LookupValue[testString]()
+	if !ok {
+		t.Fatalf("LookupValue() ok = false")
+	}
+	for input, want := range map[string]testString{`abc`: "abc", `"a;b"`: "a;b"} {
+		v, err := parse(input)
+		if err != nil || v.Value() != want {
+			t.Errorf("parse(%s) = %v, %v, want %s", input, v, err, want)
+		}
+	}
+}
+
+func TestRegisterValue_Twice(t *testing.T) {
+	t.Cleanup(func() { valueCodecs.Delete(typeOf[int64]()) })
+	next := func(v int64) Value[int64] { return NewTestValue(v + 1) }
+	RegisterDefaultValue(NewTestValue, parseTestValue)
+	// a second default is ignored
+	RegisterDefaultValue(next, nil)
+	if factory, _, _ := LookupValue[int64](); factory(5).String() != "5" {
+		t.Errorf("factory(5) = %s after a second default, want 5", factory(5))
+	}
+	// an explicit registration replaces a default
+	RegisterValue(next, nil)
+	if factory, _, _ := LookupValue[int64](); factory(5).String() != "6" {
+		t.Errorf("factory(5) = %s after RegisterValue, want 6", factory(5))
+	}
+	RegisterDefaultValue(NewTestValue, parseTestValue)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("RegisterValue() of a registered type did not panic")
+		}
+		// the first explicit registration is kept
+		if factory, _, _ := LookupValue[int64](); factory(5).String() != "6" {
+			t.Errorf("factory(5) = %s after a second registration, want 6", factory(5))
+		}
+	}()
+	RegisterValue(NewTestValue, parseTestValue)
+}
+
+type testName[T ~string] struct {
+	v T
+}
+
+func (n *testName[T]) String() string          { return string(n.v) }
+func (n *testName[T]) Next() (Value[T], error) { return nil, ErrHasNoNextValue }
+func (n *testName[T]) Prev() (Value[T], error) { return nil, ErrHasNoPrevValue }
+func (n *testName[T]) Value() T                { return n.v }
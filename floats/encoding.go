@@ -0,0 +1,37 @@
+package segment_float
+
+import (
+	rng "github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/ordered"
+)
+
+func init() {
+	rng.RegisterDefaultValue(Float[float32], ParseFloat[float32])
+	rng.RegisterDefaultValue(Float[float64], ParseFloat[float64])
+}
+
+// UnmarshalJSON decodes a segment from an object or from a string in interval notation, see ordered.OrderedSegment.
+func (s *FloatSegment[T]) UnmarshalJSON(data []byte) error {
+	from, till, err := rng.UnmarshalSegmentJSON(data, Float[T], ParseFloat[T])
+	if err != nil {
+		return err
+	}
+	return s.set(from, till)
+}
+
+// UnmarshalText decodes a segment in interval notation: (0.5;1], (-inf;1e-3).
+func (s *FloatSegment[T]) UnmarshalText(text []byte) error {
+	from, till, err := rng.ParseBorders(string(text), ParseFloat[T])
+	if err != nil {
+		return err
+	}
+	return s.set(from, till)
+}
+
+func (s *FloatSegment[T]) set(from, till rng.Border[T]) error {
+	if isNaN(from) || isNaN(till) {
+		return ErrNaN
+	}
+	s.OrderedSegment = ordered.NewOrderedSegment(from, till)
+	return nil
+}
@@ -0,0 +1,39 @@
+package segment_float
+
+import (
+	rng "github.com/pioniro/segment-go"
+	"math"
+	"strconv"
+)
+
+// Parse parses a float segment in interval notation, that is produced by String: (0.5;1], (-inf;1e-3), [-2.5, 3].
+// Infinite values are quoted to differ from unbound borders: [1;"+Inf"] includes +Inf, but [1;+inf) is unbound.
+// See segment.ParseBorders for details.
+func Parse[T floatLike](s string) (*FloatSegment[T], error) {
+	from, till, err := rng.ParseBorders(s, ParseFloat[T])
+	if err != nil {
+		return nil, err
+	}
+	return NewFloatSegment(from, till)
+}
+
+// MustParse is like Parse, but panics if a string cannot be parsed.
+func MustParse[T floatLike](s string) *FloatSegment[T] {
+	seg, err := Parse[T](s)
+	if err != nil {
+		panic(err)
+	}
+	return seg
+}
+
+// ParseFloat parses a decimal representation of a float value. NaN is rejected with ErrNaN.
+func ParseFloat[T floatLike](s string) (rng.Value[T], error) {
+	v, err := strconv.ParseFloat(s, bitSize[T]())
+	if err != nil {
+		return nil, err
+	}
+	if math.IsNaN(v) {
+		return nil, ErrNaN
+	}
+	return Float(T(v)), nil
+}
@@ -0,0 +1,71 @@
+package segment_float
+
+import (
+	"errors"
+	rng "github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/ordered"
+	"math"
+)
+
+var ErrNaN = errors.New("NaN can't be a border of a segment")
+
+type FloatSegment[T floatLike] struct {
+	*ordered.OrderedSegment[T]
+}
+
+// NewFloatSegment creates a new segment, it returns ErrNaN if any border is NaN,
+// because NaN is not comparable with other values.
+func NewFloatSegment[T floatLike](from, till rng.Border[T]) (*FloatSegment[T], error) {
+	if isNaN(from) || isNaN(till) {
+		return nil, ErrNaN
+	}
+	return &FloatSegment[T]{
+		OrderedSegment: ordered.NewOrderedSegment(from, till),
+	}, nil
+}
+
+// MustNewFloatSegment is like NewFloatSegment, but panics if any border is NaN.
+func MustNewFloatSegment[T floatLike](from, till rng.Border[T]) *FloatSegment[T] {
+	s, err := NewFloatSegment(from, till)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// TryTo tries to create a new segment from a given segment, but with different borders if it is possible.
+// Borders are converted exactly with math.Nextafter: (0.5; 1] -> [0.5000000000000001; 1], [0.5; 1] -> [0.5; 1.0000000000000002).
+//
+// If from value is Excluded(+Inf) and we want to cast it to Included, then we return an error ErrHasNoNextValue.
+// If till value is Excluded(-Inf) and we want to cast it to Included, then we return an error ErrHasNoPrevValue.
+func (s *FloatSegment[T]) TryTo(from rng.Bound, till rng.Bound) (rng.TryToSegment[T], error) {
+	f, err := ordered.LeftBoundTo(*s.From(), from)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := ordered.RightBoundTo(*s.Till(), till)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFloatSegment(f, t)
+}
+
+// Size returns a measure of a segment: till - from. Bounds don't matter, so Size( (0.5; 1] ) == Size( [0.5; 1] ) == 0.5.
+// An empty segment has zero size, and a segment with an unbound border has +Inf size.
+// A size can be +Inf if it is bigger than max(T): Size( [-MaxFloat64; MaxFloat64] ) == +Inf.
+func (s *FloatSegment[T]) Size() (T, error) {
+	var zero T
+	if s.IsEmpty() {
+		return zero, nil
+	}
+	if s.From().IsUnbound() || s.Till().IsUnbound() {
+		return T(math.Inf(1)), nil
+	}
+	return s.Till().Value().Value() - s.From().Value().Value(), nil
+}
+
+func isNaN[T floatLike](b rng.Border[T]) bool {
+	return !b.IsUnbound() && math.IsNaN(float64(b.Value().Value()))
+}
@@ -0,0 +1,205 @@
+package segment_float
+
+import (
+	"encoding/json"
+	"errors"
+	rng "github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/ordered"
+	"math"
+	"testing"
+)
+
+func TestNewFloatSegment_NaN(t *testing.T) {
+	nan := rng.NewIncluded(Float(math.NaN()))
+	one := rng.NewIncluded(Float(1.0))
+	for _, borders := range [][2]rng.Border[float64]{{nan, one}, {one, nan}, {rng.NewUnbound[float64](), nan}} {
+		if _, err := NewFloatSegment(borders[0], borders[1]); !errors.Is(err, ErrNaN) {
+			t.Errorf("NewFloatSegment() error = %v, want ErrNaN", err)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustNewFloatSegment() did not panic")
+		}
+	}()
+	MustNewFloatSegment(nan, one)
+}
+
+func TestFloatSegment_IsIncludes(t *testing.T) {
+	s := MustParse[float64]("(0.5;1.0]")
+	tests := map[float64]bool{
+		0.5:                false,
+		0.5000000000000001: true,
+		0.75:               true,
+		1:                  true,
+		1.0000000000000002: false,
+		math.NaN():         false,
+	}
+	for point, want := range tests {
+		if got := s.IsIncludes(point); got != want {
+			t.Errorf("IsIncludes(%v) = %v, want %v", point, got, want)
+		}
+	}
+}
+
+func TestFloatSegment_IsEmpty(t *testing.T) {
+	tests := map[string]bool{
+		"(1;1)":                          true,
+		"[1;1]":                          false,
+		"(1;1.0000000000000002)":         true,
+		"(1;1.0000000000000004)":         false,
+		"(-inf;+inf)":                    false,
+		"[2;1]":                          true,
+		"(1.7976931348623157e+308;+inf)": false,
+	}
+	for input, want := range tests {
+		if got := MustParse[float64](input).IsEmpty(); got != want {
+			t.Errorf("IsEmpty(%s) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestFloatSegment_TryTo(t *testing.T) {
+	tests := []struct {
+		input   string
+		from    rng.Bound
+		till    rng.Bound
+		want    string
+		wantErr error
+	}{
+		{input: "(0.5;1]", from: rng.Included, till: rng.Included, want: "[0.5000000000000001;1]"},
+		{input: "[0.5;1]", from: rng.Excluded, till: rng.Excluded, want: "(0.49999999999999994;1.0000000000000002)"},
+		{input: "[0.5;1]", from: rng.Included, till: rng.Included, want: "[0.5;1]"},
+		{input: "(-inf;1)", from: rng.Included, till: rng.Included, want: "(-inf;0.9999999999999999]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := MustParse[float64](tt.input).TryTo(tt.from, tt.till)
+			if err != nil {
+				t.Fatalf("TryTo() error = %v", err)
+			}
+			if got.(*FloatSegment[float64]).String() != tt.want {
+				t.Errorf("TryTo() = %s, want %s", got.(*FloatSegment[float64]).String(), tt.want)
+			}
+			// conversions are exact, so a segment can be converted back
+			orig := MustParse[float64](tt.input)
+			fromBound, tillBound := rng.Excluded, rng.Excluded
+			if orig.From().IsIncluded() {
+				fromBound = rng.Included
+			}
+			if orig.Till().IsIncluded() {
+				tillBound = rng.Included
+			}
+			back, err := got.TryTo(fromBound, tillBound)
+			if err != nil || back.(*FloatSegment[float64]).String() != orig.String() {
+				t.Errorf("TryTo() back = %v, %v, want %s", back, err, orig.String())
+			}
+		})
+	}
+	inf := MustNewFloatSegment(rng.NewExcluded(Float(math.Inf(1))), rng.NewUnbound[float64]())
+	if _, err := inf.TryTo(rng.Included, rng.Unbound); err != rng.ErrHasNoNextValue {
+		t.Errorf("TryTo() error = %v, want ErrHasNoNextValue", err)
+	}
+}
+
+func TestFloatSegment_Size(t *testing.T) {
+	tests := map[string]float64{
+		"(0.5;1]":        0.5,
+		"[0.5;1]":        0.5,
+		"[1;1]":          0,
+		"(1;1)":          0,
+		"[2;1]":          0,
+		"(-inf;1]":       math.Inf(1),
+		"[0;+inf)":       math.Inf(1),
+		"[-1e308;1e308]": math.Inf(1),
+	}
+	for input, want := range tests {
+		got, err := MustParse[float64](input).Size()
+		if err != nil || got != want {
+			t.Errorf("Size(%s) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, input := range []string{"(0.5;1]", "(-inf;0.001)", "[-2.5;3]", "[1e-10;+inf)"} {
+		s, err := Parse[float64](input)
+		if err != nil {
+			t.Fatalf("Parse(%s) error = %v", input, err)
+		}
+		again, err := Parse[float64](s.String())
+		if err != nil || again.String() != s.String() {
+			t.Errorf("Parse(%s) = %v, %v, want %s", s.String(), again, err, s.String())
+		}
+	}
+	if got := MustParse[float64]("(-inf;0.001)").String(); got != "(-inf;0.001)" {
+		t.Errorf("String() = %s, want (-inf;0.001)", got)
+	}
+	for _, input := range []string{"[NaN;1]", "[1;x]", "[1e39;2]"} {
+		if _, err := Parse[float32](input); err == nil {
+			t.Errorf("Parse(%s) error = nil", input)
+		}
+	}
+	if _, err := Parse[float64]("[nan;1]"); !errors.Is(err, ErrNaN) {
+		t.Errorf("Parse([nan;1]) error = %v, want ErrNaN", err)
+	}
+}
+
+func TestParse_Infinity(t *testing.T) {
+	// an included ±Inf value is quoted, so it is not read back as an unbound border, that is not quoted
+	inf, err := NewFloatSegment(rng.NewIncluded(Float(1.0)), rng.NewIncluded(Float(math.Inf(1))))
+	if err != nil {
+		t.Fatalf("NewFloatSegment() error = %v", err)
+	}
+	if got := inf.String(); got != `[1;"+Inf"]` {
+		t.Errorf("String() = %s, want [1;\"+Inf\"]", got)
+	}
+	again, err := Parse[float64](inf.String())
+	if err != nil {
+		t.Fatalf("Parse(%s) error = %v", inf, err)
+	}
+	if again.Till().IsUnbound() || !again.Till().IsIncluded() || !again.IsIncludes(math.Inf(1)) {
+		t.Errorf("Parse(%s) = %s, want an included +Inf", inf, again)
+	}
+	tests := map[string]string{
+		`("-Inf";0]`: `("-Inf";0]`,
+		`[-inf;0]`:   `(-inf;0]`,
+		`[1;+inf]`:   `[1;+inf)`,
+		`[1;"+inf")`: `[1;"+Inf")`,
+	}
+	for input, want := range tests {
+		s, err := Parse[float64](input)
+		if err != nil {
+			t.Errorf("Parse(%s) error = %v", input, err)
+			continue
+		}
+		if s.String() != want {
+			t.Errorf("Parse(%s) = %s, want %s", input, s, want)
+		}
+	}
+}
+
+func TestFloatSegment_JSON(t *testing.T) {
+	var s struct {
+		Threshold *FloatSegment[float64]           `json:"threshold"`
+		Other     *ordered.OrderedSegment[float32] `json:"other"`
+	}
+	input := `{"threshold":"(0.5;1.0]","other":{"from":{"bound":"included","value":0.25},"till":{"bound":"unbound"}}}`
+	if err := json.Unmarshal([]byte(input), &s); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if s.Threshold.String() != "(0.5;1]" || s.Other.String() != "[0.25;+inf)" {
+		t.Errorf("Unmarshal() = %s %s, want (0.5;1] [0.25;+inf)", s.Threshold, s.Other)
+	}
+	data, err := json.Marshal(s.Threshold)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"from":{"bound":"excluded","value":0.5},"till":{"bound":"included","value":1}}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	var text FloatSegment[float64]
+	if err := text.UnmarshalText([]byte("[NaN;1]")); err == nil {
+		t.Errorf("UnmarshalText() of NaN error = nil")
+	}
+}
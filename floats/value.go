@@ -0,0 +1,66 @@
+package segment_float
+
+import (
+	"github.com/pioniro/segment-go"
+	"math"
+	"strconv"
+)
+
+type floatLike interface {
+	~float32 | ~float64
+}
+
+type floatValue[T floatLike] struct {
+	value T
+}
+
+func Float[T floatLike](v T) segment.Value[T] {
+	return &floatValue[T]{
+		value: v,
+	}
+}
+
+func (v *floatValue[T]) Value() T {
+	return v.value
+}
+
+// String returns the shortest decimal representation of a value, that can be parsed back: 0.5, 1e+21, -Inf.
+func (v *floatValue[T]) String() string {
+	return strconv.FormatFloat(float64(v.value), 'g', -1, bitSize[T]())
+}
+
+// Next returns a next representable value of a given value (math.Nextafter).
+// There is no next value of +Inf and NaN.
+func (v *floatValue[T]) Next() (segment.Value[T], error) {
+	if math.IsInf(float64(v.value), 1) || math.IsNaN(float64(v.value)) {
+		return Float(v.value), segment.ErrHasNoNextValue
+	}
+	return Float(nextafter(v.value, math.Inf(1))), nil
+}
+
+// Prev returns a prev representable value of a given value (math.Nextafter).
+// There is no prev value of -Inf and NaN.
+func (v *floatValue[T]) Prev() (segment.Value[T], error) {
+	if math.IsInf(float64(v.value), -1) || math.IsNaN(float64(v.value)) {
+		return Float(v.value), segment.ErrHasNoPrevValue
+	}
+	return Float(nextafter(v.value, math.Inf(-1))), nil
+}
+
+// bitSize returns 32 for float32 based types and 64 for float64 based ones.
+func bitSize[T floatLike]() int {
+	// float32 can't hold MaxFloat64, so it becomes +Inf
+	maxFloat64 := math.MaxFloat64
+	if math.IsInf(float64(T(maxFloat64)), 1) {
+		return 32
+	}
+	return 64
+}
+
+// nextafter is math.Nextafter or math.Nextafter32 depending on a size of T.
+func nextafter[T floatLike](v T, to float64) T {
+	if bitSize[T]() == 32 {
+		return T(math.Nextafter32(float32(v), float32(to)))
+	}
+	return T(math.Nextafter(float64(v), to))
+}
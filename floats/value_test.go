@@ -0,0 +1,85 @@
+package segment_float
+
+import (
+	rng "github.com/pioniro/segment-go"
+	"math"
+	"testing"
+)
+
+func TestFloat_Next(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       float64
+		want    float64
+		wantErr error
+	}{
+		{name: "1", v: 1, want: 1.0000000000000002},
+		{name: "0", v: 0, want: 5e-324},
+		{name: "max", v: math.MaxFloat64, want: math.Inf(1)},
+		{name: "-inf", v: math.Inf(-1), want: -math.MaxFloat64},
+		{name: "+inf", v: math.Inf(1), want: math.Inf(1), wantErr: rng.ErrHasNoNextValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Float(tt.v).Next()
+			if err != tt.wantErr || got.Value() != tt.want {
+				t.Errorf("Next() = %v, %v, want %v, %v", got.Value(), err, tt.want, tt.wantErr)
+			}
+		})
+	}
+	if _, err := Float(math.NaN()).Next(); err != rng.ErrHasNoNextValue {
+		t.Errorf("Next() of NaN error = %v, want ErrHasNoNextValue", err)
+	}
+}
+
+func TestFloat_Prev(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       float64
+		want    float64
+		wantErr error
+	}{
+		{name: "1", v: 1, want: 0.9999999999999999},
+		{name: "0", v: 0, want: -5e-324},
+		{name: "+inf", v: math.Inf(1), want: math.MaxFloat64},
+		{name: "-inf", v: math.Inf(-1), want: math.Inf(-1), wantErr: rng.ErrHasNoPrevValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Float(tt.v).Prev()
+			if err != tt.wantErr || got.Value() != tt.want {
+				t.Errorf("Prev() = %v, %v, want %v, %v", got.Value(), err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFloat_Float32(t *testing.T) {
+	next, _ := Float[float32](1).Next()
+	if want := math.Nextafter32(1, 2); next.Value() != want {
+		t.Errorf("Next() = %v, want %v", next.Value(), want)
+	}
+	if got := next.String(); got != "1.0000001" {
+		t.Errorf("String() = %s, want 1.0000001", got)
+	}
+	type ratio float32
+	prev, _ := Float[ratio](1).Prev()
+	if want := ratio(math.Nextafter32(1, 0)); prev.Value() != want {
+		t.Errorf("Prev() = %v, want %v", prev.Value(), want)
+	}
+}
+
+func TestFloat_String(t *testing.T) {
+	tests := map[float64]string{
+		0.5:                    "0.5",
+		-2:                     "-2",
+		1e21:                   "1e+21",
+		math.Nextafter(0.3, 1): "0.30000000000000004",
+		math.Inf(1):            "+Inf",
+	}
+	for v, want := range tests {
+		if got := Float(v).String(); got != want {
+			t.Errorf("String(%v) = %s, want %s", v, got, want)
+		}
+	}
+}
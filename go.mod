@@ -1,5 +1,5 @@
 module github.com/pioniro/segment-go
 
-go 1.21
+go 1.23
 
 require github.com/pioniro/generator-go v1.0.0
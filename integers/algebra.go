@@ -0,0 +1,64 @@
+package segment_int
+
+import (
+	rng "github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/ordered"
+)
+
+// Intersect returns an intersection of two segments, canonicalised to included borders.
+// If segments do not intersect, then false will be returned.
+//
+//	[1;5) ∩ (3;8] = [4;4]
+func (s *IntSegment[T]) Intersect(o *IntSegment[T]) (*IntSegment[T], bool) {
+	res, ok := s.OrderedSegment.Intersect(o.OrderedSegment)
+	if !ok {
+		return nil, false
+	}
+	return canonical(res), true
+}
+
+// Union returns a union of two segments, canonicalised to included borders.
+// If segments overlap or are adjacent, then one segment will be returned, otherwise two segments ordered by their borders.
+//
+//	[1;3] ∪ [4;6] = [1;6]
+func (s *IntSegment[T]) Union(o *IntSegment[T]) []*IntSegment[T] {
+	return canonicalAll(s.OrderedSegment.Union(o.OrderedSegment))
+}
+
+// Difference returns a part of a segment, that is not included in other segment, canonicalised to included borders.
+// The result can consist of zero, one or two segments ordered by their borders.
+//
+//	[1;5] \ [2;3) = [1;1], [3;5]
+func (s *IntSegment[T]) Difference(o *IntSegment[T]) []*IntSegment[T] {
+	return canonicalAll(s.OrderedSegment.Difference(o.OrderedSegment))
+}
+
+// SymmetricDifference returns values, that are included in exactly one of two segments, canonicalised to included borders.
+// The result can consist of zero, one or two segments ordered by their borders.
+//
+//	[1;5] △ [3;8) = [1;2], [6;7]
+func (s *IntSegment[T]) SymmetricDifference(o *IntSegment[T]) []*IntSegment[T] {
+	return canonicalAll(s.OrderedSegment.SymmetricDifference(o.OrderedSegment))
+}
+
+// canonical casts a non-empty segment to a segment with included (or unbound) borders: (1;5) -> [2;4]
+func canonical[T intLike](s *ordered.OrderedSegment[T]) *IntSegment[T] {
+	seg := NewIntSegment(*s.From(), *s.Till())
+	inc, err := seg.TryTo(rng.Included, rng.Included)
+	// non-empty segment can always be cast to included borders
+	if err != nil {
+		return seg
+	}
+	return inc.(*IntSegment[T])
+}
+
+func canonicalAll[T intLike](segments []*ordered.OrderedSegment[T]) []*IntSegment[T] {
+	if len(segments) == 0 {
+		return nil
+	}
+	result := make([]*IntSegment[T], len(segments))
+	for i, seg := range segments {
+		result[i] = canonical(seg)
+	}
+	return result
+}
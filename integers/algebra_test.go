@@ -0,0 +1,179 @@
+package segment_int
+
+import (
+	. "github.com/pioniro/segment-go"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestIntSegment_Intersect(t *testing.T) {
+	type testCase[T intLike] struct {
+		name   string
+		s      *IntSegment[T]
+		o      *IntSegment[T]
+		want   *IntSegment[T]
+		wantOk bool
+	}
+	tests := []testCase[int64]{
+		{
+			name:   "[1;5) ∩ (3;8]",
+			s:      NewIntSegment(NewIncluded(Int[int64](1)), NewExcluded(Int[int64](5))),
+			o:      NewIntSegment(NewExcluded(Int[int64](3)), NewIncluded(Int[int64](8))),
+			want:   NewIntSegment(NewIncluded(Int[int64](4)), NewIncluded(Int[int64](4))),
+			wantOk: true,
+		},
+		{
+			name:   "(1;3) ∩ (2;5)",
+			s:      NewIntSegment(NewExcluded(Int[int64](1)), NewExcluded(Int[int64](3))),
+			o:      NewIntSegment(NewExcluded(Int[int64](2)), NewExcluded(Int[int64](5))),
+			wantOk: false,
+		},
+		{
+			name:   "(inf;5) ∩ (inf;inf)",
+			s:      NewIntSegment(NewUnbound[int64](), NewExcluded(Int[int64](5))),
+			o:      NewIntSegment(NewUnbound[int64](), NewUnbound[int64]()),
+			want:   NewIntSegment(NewUnbound[int64](), NewIncluded(Int[int64](4))),
+			wantOk: true,
+		},
+		{
+			name:   "(MAX;inf) ∩ (inf;inf)",
+			s:      NewIntSegment(NewExcluded(Int[int64](math.MaxInt64)), NewUnbound[int64]()),
+			o:      NewIntSegment(NewUnbound[int64](), NewUnbound[int64]()),
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.s.Intersect(tt.o)
+			if ok != tt.wantOk {
+				t.Fatalf("Intersect() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intersect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntSegment_Union(t *testing.T) {
+	type testCase[T intLike] struct {
+		name string
+		s    *IntSegment[T]
+		o    *IntSegment[T]
+		want []*IntSegment[T]
+	}
+	tests := []testCase[uint8]{
+		{
+			name: "[1;3] ∪ [4;6]",
+			s:    NewIntSegment(NewIncluded(Int[uint8](1)), NewIncluded(Int[uint8](3))),
+			o:    NewIntSegment(NewIncluded(Int[uint8](4)), NewIncluded(Int[uint8](6))),
+			want: []*IntSegment[uint8]{
+				NewIntSegment(NewIncluded(Int[uint8](1)), NewIncluded(Int[uint8](6))),
+			},
+		},
+		{
+			name: "[1;3) ∪ (3;6)",
+			s:    NewIntSegment(NewIncluded(Int[uint8](1)), NewExcluded(Int[uint8](3))),
+			o:    NewIntSegment(NewExcluded(Int[uint8](3)), NewExcluded(Int[uint8](6))),
+			want: []*IntSegment[uint8]{
+				NewIntSegment(NewIncluded(Int[uint8](1)), NewIncluded(Int[uint8](2))),
+				NewIntSegment(NewIncluded(Int[uint8](4)), NewIncluded(Int[uint8](5))),
+			},
+		},
+		{
+			name: "[0;254] ∪ (254;inf)",
+			s:    NewIntSegment(NewIncluded(Int[uint8](0)), NewIncluded(Int[uint8](254))),
+			o:    NewIntSegment(NewExcluded(Int[uint8](254)), NewUnbound[uint8]()),
+			want: []*IntSegment[uint8]{
+				NewIntSegment(NewIncluded(Int[uint8](0)), NewUnbound[uint8]()),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Union(tt.o); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Union() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntSegment_Difference(t *testing.T) {
+	type testCase[T intLike] struct {
+		name string
+		s    *IntSegment[T]
+		o    *IntSegment[T]
+		want []*IntSegment[T]
+	}
+	tests := []testCase[int8]{
+		{
+			name: "[1;5] \\ [2;3)",
+			s:    NewIntSegment(NewIncluded(Int[int8](1)), NewIncluded(Int[int8](5))),
+			o:    NewIntSegment(NewIncluded(Int[int8](2)), NewExcluded(Int[int8](3))),
+			want: []*IntSegment[int8]{
+				NewIntSegment(NewIncluded(Int[int8](1)), NewIncluded(Int[int8](1))),
+				NewIntSegment(NewIncluded(Int[int8](3)), NewIncluded(Int[int8](5))),
+			},
+		},
+		{
+			name: "(inf;inf) \\ (inf;0)",
+			s:    NewIntSegment(NewUnbound[int8](), NewUnbound[int8]()),
+			o:    NewIntSegment(NewUnbound[int8](), NewExcluded(Int[int8](0))),
+			want: []*IntSegment[int8]{
+				NewIntSegment(NewIncluded(Int[int8](0)), NewUnbound[int8]()),
+			},
+		},
+		{
+			name: "[-128;127] \\ (-128;127)",
+			s:    NewIntSegment(NewIncluded(Int[int8](-128)), NewIncluded(Int[int8](127))),
+			o:    NewIntSegment(NewExcluded(Int[int8](-128)), NewExcluded(Int[int8](127))),
+			want: []*IntSegment[int8]{
+				NewIntSegment(NewIncluded(Int[int8](-128)), NewIncluded(Int[int8](-128))),
+				NewIntSegment(NewIncluded(Int[int8](127)), NewIncluded(Int[int8](127))),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Difference(tt.o); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Difference() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntSegment_SymmetricDifference(t *testing.T) {
+	type testCase[T intLike] struct {
+		name string
+		s    *IntSegment[T]
+		o    *IntSegment[T]
+		want []*IntSegment[T]
+	}
+	tests := []testCase[int64]{
+		{
+			name: "[1;5] △ [3;8)",
+			s:    NewIntSegment(NewIncluded(Int[int64](1)), NewIncluded(Int[int64](5))),
+			o:    NewIntSegment(NewIncluded(Int[int64](3)), NewExcluded(Int[int64](8))),
+			want: []*IntSegment[int64]{
+				NewIntSegment(NewIncluded(Int[int64](1)), NewIncluded(Int[int64](2))),
+				NewIntSegment(NewIncluded(Int[int64](6)), NewIncluded(Int[int64](7))),
+			},
+		},
+		{
+			name: "[1;3] △ [4;5]",
+			s:    NewIntSegment(NewIncluded(Int[int64](1)), NewIncluded(Int[int64](3))),
+			o:    NewIntSegment(NewIncluded(Int[int64](4)), NewIncluded(Int[int64](5))),
+			want: []*IntSegment[int64]{
+				NewIntSegment(NewIncluded(Int[int64](1)), NewIncluded(Int[int64](5))),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.SymmetricDifference(tt.o); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SymmetricDifference() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
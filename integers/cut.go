@@ -0,0 +1,67 @@
+package segment_int
+
+import (
+	rng "github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/internal/runs"
+	"github.com/pioniro/segment-go/ordered"
+	"iter"
+)
+
+// SplitAt cuts a segment before given points, so each point is the first value of a piece:
+// [1;10] at 3, 7 = [1;3), [3;7), [7;10]. See SplitAtCuts to choose a side of each cut.
+func (s *IntSegment[T]) SplitAt(points ...T) []*IntSegment[T] {
+	return wrapPieces(s.OrderedSegment.SplitAt(Int[T], points...))
+}
+
+// SplitAtCuts cuts a segment at given points, a side of each cut defines, which piece its point belongs to:
+//
+//	[1;10] at 3 after, 7 after  = [1;3], (3;7], (7;10]
+//	[1;10] at 5 before, 5 after = [1;5), [5;5], (5;10]
+//
+// See ordered.OrderedSegment.SplitAtCuts for details.
+func (s *IntSegment[T]) SplitAtCuts(cuts ...ordered.CutPoint[T]) []*IntSegment[T] {
+	return wrapPieces(s.OrderedSegment.SplitAtCuts(Int[T], cuts...))
+}
+
+// wrapPieces wraps pieces of a segment without canonicalisation, so borders of cuts are kept as they are.
+func wrapPieces[T intLike](pieces []*ordered.OrderedSegment[T]) []*IntSegment[T] {
+	if len(pieces) == 0 {
+		return nil
+	}
+	result := make([]*IntSegment[T], len(pieces))
+	for i, piece := range pieces {
+		result[i] = &IntSegment[T]{OrderedSegment: piece}
+	}
+	return result
+}
+
+// SplitBy groups consecutive values of a segment into maximal runs with the same key and returns an iterator over keys and runs:
+// [1;25] by v/10 -> 0: [1;9], 1: [10;19], 2: [20;25]. Runs are canonicalised to included borders.
+//
+// Values are not iterated one by one, the end of a run is found with an exponential and a binary search,
+// so key is called O(log(size)) times per run. It requires, that values with the same key are contiguous:
+// once a key changes, it does not appear again, as with partition boundaries or a month of a day.
+// Unbound borders are min(T) and max(T), as in Split.
+func SplitBy[T intLike, K comparable](s *IntSegment[T], key func(T) K) iter.Seq2[K, *IntSegment[T]] {
+	return func(yield func(K, *IntSegment[T]) bool) {
+		start, finish, ok := s.bounds()
+		if !ok {
+			return
+		}
+		// values are start+offset, so offsets never overflow: [0; span]
+		span := uint64(finish) - uint64(start)
+		at := func(offset uint64) T {
+			return T(uint64(start) + offset)
+		}
+		for offset := uint64(0); ; {
+			k := key(at(offset))
+			end := runs.End(offset, span, func(o uint64) bool {
+				return key(at(o)) == k
+			})
+			if !yield(k, NewIntSegment(rng.NewIncluded(Int(at(offset))), rng.NewIncluded(Int(at(end))))) || end == span {
+				return
+			}
+			offset = end + 1
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package segment_int
+
+import (
+	"fmt"
+	. "github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/ordered"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestIntSegment_SplitAt(t *testing.T) {
+	tests := []struct {
+		input  string
+		points []int8
+		want   string
+	}{
+		{input: "[1;10]", points: []int8{7, 3}, want: "[1;3) [3;7) [7;10]"},
+		{input: "(0;10)", points: []int8{1}, want: "[1;10)"},
+		{input: "(-inf;+inf)", points: []int8{-128, 127}, want: "[-128;127) [127;+inf)"},
+		{input: "(1;2)", points: []int8{1}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := joinParts(MustParse[int8](tt.input).SplitAt(tt.points...)); got != tt.want {
+				t.Errorf("SplitAt(%v) = %v, want %v", tt.points, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntSegment_SplitAtCuts(t *testing.T) {
+	before := func(v int8) ordered.CutPoint[int8] { return ordered.CutPoint[int8]{Value: v, Side: ordered.CutBefore} }
+	after := func(v int8) ordered.CutPoint[int8] { return ordered.CutPoint[int8]{Value: v, Side: ordered.CutAfter} }
+	tests := []struct {
+		input string
+		cuts  []ordered.CutPoint[int8]
+		want  string
+	}{
+		{input: "[1;10]", cuts: []ordered.CutPoint[int8]{after(3), after(7)}, want: "[1;3] (3;7] (7;10]"},
+		{input: "[1;10]", cuts: []ordered.CutPoint[int8]{after(5), before(5)}, want: "[1;5) [5;5] (5;10]"},
+		{input: "(0;10)", cuts: []ordered.CutPoint[int8]{after(9)}, want: "(0;9]"},
+		{input: "(-inf;+inf)", cuts: []ordered.CutPoint[int8]{after(127), after(0)}, want: "(-inf;0] (0;127]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := joinParts(MustParse[int8](tt.input).SplitAtCuts(tt.cuts...)); got != tt.want {
+				t.Errorf("SplitAtCuts(%v) = %v, want %v", tt.cuts, got, tt.want)
+			}
+		})
+	}
+}
+
+func joinRuns[T intLike, K comparable](runs func(func(K, *IntSegment[T]) bool)) string {
+	var parts []string
+	for k, run := range runs {
+		parts = append(parts, fmt.Sprintf("%v:%v", k, run))
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestSplitBy(t *testing.T) {
+	tens := func(v int8) int8 { return v / 10 }
+	tests := []struct {
+		input string
+		key   func(int8) int8
+		want  string
+	}{
+		{input: "[1;25]", key: tens, want: "0:[1;9] 1:[10;19] 2:[20;25]"},
+		{input: "(-12;10)", key: tens, want: "-1:[-11;-10] 0:[-9;9]"},
+		{input: "[5;5]", key: tens, want: "0:[5;5]"},
+		{input: "(-inf;+inf)", key: func(v int8) int8 { return 0 }, want: "0:[-128;127]"},
+		{input: "(-inf;+inf)", key: func(v int8) int8 { return v }, want: ""},
+		{input: "(1;2)", key: tens, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := joinRuns(SplitBy(MustParse[int8](tt.input), tt.key))
+			if tt.want == "" && tt.input == "(-inf;+inf)" {
+				if n := strings.Count(got, " ") + 1; n != 256 {
+					t.Errorf("SplitBy() has %d runs, want 256", n)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("SplitBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitBy_Calls(t *testing.T) {
+	s := NewIntSegment(NewUnbound[int64](), NewUnbound[int64]())
+	calls := 0
+	partition := func(v int64) int64 {
+		calls++
+		switch {
+		case v < -1000:
+			return 0
+		case v < 1<<40:
+			return 1
+		}
+		return 2
+	}
+	got := joinRuns(SplitBy(s, partition))
+	want := "0:[-9223372036854775808;-1001] 1:[-1000;1099511627775] 2:[1099511627776;9223372036854775807]"
+	if got != want {
+		t.Errorf("SplitBy() = %v, want %v", got, want)
+	}
+	if calls > 3*2*64+3 {
+		t.Errorf("SplitBy() calls key %d times", calls)
+	}
+	var n int
+	for range SplitBy(MustParse[uint64]("[0;+inf)"), func(v uint64) bool { return v > math.MaxUint64-5 }) {
+		n++
+	}
+	if n != 2 {
+		t.Errorf("SplitBy() of uint64 has %d runs, want 2", n)
+	}
+}
@@ -0,0 +1,45 @@
+package segment_int
+
+import (
+	rng "github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/ordered"
+)
+
+func init() {
+	register[int]()
+	register[int8]()
+	register[int16]()
+	register[int32]()
+	register[int64]()
+	register[uint]()
+	register[uint8]()
+	register[uint16]()
+	register[uint32]()
+	register[uint64]()
+}
+
+// register registers Int and ParseInt as a value factory of T, so borders and ordered segments of T can be unmarshaled.
+func register[T intLike]() {
+	rng.RegisterDefaultValue(Int[T], ParseInt[T])
+}
+
+// UnmarshalJSON decodes a segment from an object or from a string in interval notation, see ordered.OrderedSegment.
+// Unlike OrderedSegment it does not need a registered factory, so it works for derived types too.
+func (s *IntSegment[T]) UnmarshalJSON(data []byte) error {
+	from, till, err := rng.UnmarshalSegmentJSON(data, Int[T], ParseInt[T])
+	if err != nil {
+		return err
+	}
+	s.OrderedSegment = ordered.NewOrderedSegment(from, till)
+	return nil
+}
+
+// UnmarshalText decodes a segment in interval notation: [1;2), (-inf;5].
+func (s *IntSegment[T]) UnmarshalText(text []byte) error {
+	seg, err := Parse[T](string(text))
+	if err != nil {
+		return err
+	}
+	s.OrderedSegment = seg.OrderedSegment
+	return nil
+}
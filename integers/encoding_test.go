@@ -0,0 +1,66 @@
+package segment_int
+
+import (
+	"encoding/json"
+	. "github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/ordered"
+	"testing"
+	"time"
+)
+
+func TestIntSegment_MarshalJSON(t *testing.T) {
+	s := struct {
+		Object   *IntSegment[uint8] `json:"object"`
+		Notation *IntSegment[uint8] `json:"notation"`
+	}{}
+	input := `{"object":{"from":{"bound":"included","value":1},"till":{"bound":"unbound"}},"notation":"(1;255]"}`
+	if err := json.Unmarshal([]byte(input), &s); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if s.Object.String() != "[1;+inf)" || s.Notation.String() != "(1;255]" {
+		t.Errorf("Unmarshal() = %s %s, want [1;+inf) (1;255]", s.Object, s.Notation)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"object":{"from":{"bound":"included","value":1},"till":{"bound":"unbound"}},"notation":{"from":{"bound":"excluded","value":1},"till":{"bound":"included","value":255}}}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	if err := json.Unmarshal([]byte(`"[1;256]"`), s.Object); err == nil {
+		t.Errorf("Unmarshal() of overflowed value error = nil")
+	}
+}
+
+func TestIntSegment_UnmarshalText(t *testing.T) {
+	var got IntSegment[time.Duration]
+	if err := got.UnmarshalText([]byte("[1;5)")); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if got.String() != "[1;5)" {
+		t.Errorf("UnmarshalText() = %s, want [1;5)", got.String())
+	}
+	text, err := got.MarshalText()
+	if err != nil || string(text) != "[1;5)" {
+		t.Errorf("MarshalText() = %s, %v, want [1;5)", text, err)
+	}
+}
+
+func TestRegisteredValues(t *testing.T) {
+	var seg ordered.OrderedSegment[int32]
+	if err := json.Unmarshal([]byte(`"[-1;2]"`), &seg); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	from, _ := seg.From().Value().Next()
+	if from.Value() != 0 {
+		t.Errorf("Unmarshal() created a value, whose Next() = %d, want 0", from.Value())
+	}
+	var border Border[uint16]
+	if err := border.UnmarshalText([]byte("(65535)")); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if _, err := border.Value().Next(); err != ErrHasNoNextValue {
+		t.Errorf("Next() error = %v, want ErrHasNoNextValue", err)
+	}
+}
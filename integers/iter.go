@@ -0,0 +1,169 @@
+package segment_int
+
+import (
+	rng "github.com/pioniro/segment-go"
+	"iter"
+)
+
+// All returns an iterator over all values of a segment in ascending order.
+// It is the same as Iterate, but can be used with range-over-func: for v := range seg.All() {...}
+func (s *IntSegment[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		start, finish, ok := s.bounds()
+		if !ok {
+			return
+		}
+		// i == finish is checked before i++, so i never overflows, even if finish is max(T)
+		for i := start; ; i++ {
+			if !yield(i) || i == finish {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over all values of a segment in descending order.
+func (s *IntSegment[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		start, finish, ok := s.bounds()
+		if !ok {
+			return
+		}
+		// i == start is checked before i--, so i never overflows, even if start is min(T)
+		for i := finish; ; i-- {
+			if !yield(i) || i == start {
+				return
+			}
+		}
+	}
+}
+
+// Step returns an iterator over values of a segment with a given step:
+// [1;10] with step 3 -> 1, 4, 7, 10; with step -4 -> 10, 6, 2.
+// A positive step walks upward from the first value, a negative one walks downward from the last value.
+// If step is 0, then an empty iterator will be returned. Unbound borders are min(T) and max(T), as in All.
+func (s *IntSegment[T]) Step(step int64) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		start, finish, ok := s.bounds()
+		if !ok || step == 0 {
+			return
+		}
+		// values are start+offset, offsets are in [0; span], so neither of them overflows
+		span := uint64(finish) - uint64(start)
+		delta := uint64(step)
+		if step < 0 {
+			// -delta is correct for math.MinInt64 too: 1<<63
+			delta = -delta
+		}
+		offset, next := uint64(0), func(o uint64) (uint64, bool) {
+			return o + delta, span-o >= delta
+		}
+		if step < 0 {
+			offset, next = span, func(o uint64) (uint64, bool) {
+				return o - delta, o >= delta
+			}
+		}
+		for {
+			if !yield(T(uint64(start) + offset)) {
+				return
+			}
+			if offset, ok = next(offset); !ok {
+				return
+			}
+		}
+	}
+}
+
+// Chunks returns an iterator over chunks of a segment no larger than a given size.
+// It is the same as Split, but can be used with range-over-func: for chunk := range seg.Chunks(10) {...}
+func (s *IntSegment[T]) Chunks(size T) iter.Seq[*IntSegment[T]] {
+	var zero T
+	if size <= zero {
+		return func(yield func(*IntSegment[T]) bool) {}
+	}
+	return s.chunks(size, func(T) T { return size })
+}
+
+// chunks returns an iterator over chunks of a segment, the first one has a size first(start), and others have a given size.
+// first(start) must be from 1 to size.
+func (s *IntSegment[T]) chunks(size T, first func(start T) T) iter.Seq[*IntSegment[T]] {
+	// Since we can always cast a segment to a segment with included borders, and this greatly simplifies the algorithm (compares of values),
+	// we do this
+	//
+	// corner cases:
+	//	(A; MinInt) -> [A+1; MinInt-1], but size is less than 1, so we return empty gen
+	//	(MaxInt; A) -> [MaxInt+1; A-1], but size is less than 1, so we return empty gen
+	inc, err := mustToIncluded(s)
+	if err != nil {
+		return func(yield func(*IntSegment[T]) bool) {}
+	}
+	start := inc.From().Value().Value()
+	finish := inc.Till().Value().Value()
+	return func(yield func(*IntSegment[T]) bool) {
+		var zero T
+		l := start
+		step := first(start)
+		for l <= finish {
+			// d is a current size of a chunk
+			// invariant:
+			// 1. d <= step
+			// 2. d > 0
+			// 3. if d less than step, then we throw [..;..] range and get out of the loop
+			var d T
+			// SIGNED: int8
+			// [-128; 5]
+			//  left = 5 - (-128) = -123 // overflow, left < 0, so d = step
+			// [1; 1]
+			//  left = 1 - 1 = 0 // no overflow, left >= 0, so d = min(left, step) = 0
+			// [-127; 5]
+			//   left = 5 - (-127) = -124 // overflow, left < 0, so d = step
+			// [-128; -127]
+			//   left = -127 - (-128) = 1 // no overflow, left > 0, so d = min(left, step)
+			// UNSIGNED: uint8
+			// [0; 5]
+			//   left = 5 - 0 = 5 // no overflow, left > 0, so d = min(left, step)
+			// [1; 5]
+			//   left = 5 - 1 = 4 // no overflow, left > 0, so d = min(left, step)
+			// [0; 255]
+			//   left = 255 - 0 = 255 // no overflow, left > 0, so d = min(left, step)
+			// so, checking for overflow is not necessary, we can rely to left < zero
+			left := finish - l
+			if left < zero {
+				d = step
+			} else {
+				d = min(step, left)
+			}
+			// invariant 1: d <= step
+			// invariant 2: d > 0
+			// overflow is impossible: d < max - l, d > 0
+			r := l + d
+			// or d < step (invariant 3)
+			if d != step {
+				yield(NewIntSegment(rng.NewIncluded(Int(l)), rng.NewIncluded(Int(r))))
+				return
+			}
+			if !yield(NewIntSegment(rng.NewIncluded(Int(l)), rng.NewExcluded(Int(r)))) {
+				return
+			}
+			l = r
+			step = size
+		}
+	}
+}
+
+// bounds returns the first and the last values of a segment. If a segment is empty, then false will be returned.
+func (s *IntSegment[T]) bounds() (T, T, bool) {
+	// Since we can always cast an int segment to a segment with included borders, and this greatly simplifies the algorithm (compares of values),
+	// we do this
+	//
+	// corner cases:
+	//	(A; MinInt) -> [A+1; MinInt-1], so there are no values
+	//	(MaxInt; A) -> [MaxInt+1; A-1], so there are no values
+	inc, err := mustToIncluded(s)
+	if err != nil {
+		return 0, 0, false
+	}
+	start := inc.From().Value().Value()
+	finish := inc.Till().Value().Value()
+	return start, finish, start <= finish
+}
@@ -0,0 +1,164 @@
+package segment_int
+
+import (
+	. "github.com/pioniro/segment-go"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestIntSegment_All(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *IntSegment[int8]
+		want []int8
+	}{
+		{name: "[1;5)", s: MustParse[int8]("[1;5)"), want: []int8{1, 2, 3, 4}},
+		{name: "(1;2)", s: MustParse[int8]("(1;2)"), want: nil},
+		{name: "[5;1]", s: MustParse[int8]("[5;1]"), want: nil},
+		{name: "[125;+inf)", s: MustParse[int8]("[125;+inf)"), want: []int8{125, 126, 127}},
+		{name: "(-inf;-126]", s: MustParse[int8]("(-inf;-126]"), want: []int8{-128, -127, -126}},
+		{name: "(127;+inf)", s: MustParse[int8]("(127;+inf)"), want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Collect(tt.s.All()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("All() = %v, want %v", got, tt.want)
+			}
+			want := slices.Clone(tt.want)
+			slices.Reverse(want)
+			if got := slices.Collect(tt.s.Backward()); !reflect.DeepEqual(got, want) {
+				t.Errorf("Backward() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestIntSegment_All_Full(t *testing.T) {
+	s := NewIntSegment(NewUnbound[uint8](), NewUnbound[uint8]())
+	if got := len(slices.Collect(s.All())); got != 256 {
+		t.Errorf("len(All()) = %d, want 256", got)
+	}
+	if got := len(slices.Collect(s.Backward())); got != 256 {
+		t.Errorf("len(Backward()) = %d, want 256", got)
+	}
+}
+
+func TestIntSegment_All_Break(t *testing.T) {
+	s := NewIntSegment(NewUnbound[int64](), NewUnbound[int64]())
+	var got []int64
+	for v := range s.All() {
+		if len(got) == 3 {
+			break
+		}
+		got = append(got, v)
+	}
+	if want := []int64{math.MinInt64, math.MinInt64 + 1, math.MinInt64 + 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+	got = got[:0]
+	for v := range s.Backward() {
+		if len(got) == 2 {
+			break
+		}
+		got = append(got, v)
+	}
+	if want := []int64{math.MaxInt64, math.MaxInt64 - 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Backward() = %v, want %v", got, want)
+	}
+}
+
+func TestIntSegment_Step(t *testing.T) {
+	tests := []struct {
+		input string
+		step  int64
+		want  []int8
+	}{
+		{input: "[1;10]", step: 3, want: []int8{1, 4, 7, 10}},
+		{input: "[1;10)", step: 3, want: []int8{1, 4, 7}},
+		{input: "[1;10]", step: -4, want: []int8{10, 6, 2}},
+		{input: "(1;10)", step: -4, want: []int8{9, 5}},
+		{input: "[1;10]", step: 1, want: []int8{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{input: "[1;10]", step: 100, want: []int8{1}},
+		{input: "[1;10]", step: 0, want: nil},
+		{input: "(1;2)", step: -1, want: nil},
+		{input: "[100;+inf)", step: 9, want: []int8{100, 109, 118, 127}},
+		{input: "[100;+inf)", step: 10, want: []int8{100, 110, 120}},
+		{input: "(-inf;-100]", step: -10, want: []int8{-100, -110, -120}},
+		{input: "(-inf;-100]", step: -14, want: []int8{-100, -114, -128}},
+		{input: "(-inf;+inf)", step: 255, want: []int8{-128, 127}},
+		{input: "(-inf;+inf)", step: -255, want: []int8{127, -128}},
+		{input: "(-inf;+inf)", step: math.MinInt64, want: []int8{127}},
+		{input: "(-inf;+inf)", step: math.MaxInt64, want: []int8{-128}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := slices.Collect(MustParse[int8](tt.input).Step(tt.step)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Step(%d) = %v, want %v", tt.step, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntSegment_Step_Unsigned(t *testing.T) {
+	s := NewIntSegment(NewUnbound[uint64](), NewUnbound[uint64]())
+	var got []uint64
+	for v := range s.Step(math.MinInt64) {
+		got = append(got, v)
+	}
+	if want := []uint64{math.MaxUint64, math.MaxUint64 - 1<<63}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Step(MinInt64) = %v, want %v", got, want)
+	}
+	got = got[:0]
+	for v, err := range s.IterateStep(-1000) {
+		if err != nil {
+			t.Fatalf("IterateStep() error = %v", err)
+		}
+		if len(got) == 3 {
+			break
+		}
+		got = append(got, v)
+	}
+	if want := []uint64{math.MaxUint64, math.MaxUint64 - 1000, math.MaxUint64 - 2000}; !reflect.DeepEqual(got, want) {
+		t.Errorf("IterateStep(-1000) = %v, want %v", got, want)
+	}
+}
+
+func TestIntSegment_Chunks(t *testing.T) {
+	tests := []struct {
+		s    *IntSegment[int8]
+		size int8
+	}{
+		{s: MustParse[int8]("[1;10)"), size: 2},
+		{s: MustParse[int8]("(-inf;+inf)"), size: 100},
+		{s: MustParse[int8]("[1;1]"), size: 5},
+		{s: MustParse[int8]("(1;2)"), size: 1},
+		{s: MustParse[int8]("[1;10)"), size: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s.String(), func(t *testing.T) {
+			var got []string
+			for chunk := range tt.s.Chunks(tt.size) {
+				got = append(got, chunk.String())
+			}
+			var want []string
+			for _, chunk := range tt.s.Split(tt.size).Collect() {
+				want = append(want, chunk.(*IntSegment[int8]).String())
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Chunks() = %v, want %v", got, want)
+			}
+		})
+	}
+	var got []string
+	for chunk := range MustParse[int8]("[1;10)").Chunks(3) {
+		got = append(got, chunk.String())
+		if len(got) == 2 {
+			break
+		}
+	}
+	if want := []string{"[1;4)", "[4;7)"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Chunks() with break = %v, want %v", got, want)
+	}
+}
@@ -82,8 +82,14 @@ func intType(t interface{}) (string, error) {
 // Ensure [1] T exists in intType because intLike Require [1]
 func minInt[T intLike]() T {
 	t := new(T)
-	// errors are ignored, because Ensure [1]
-	tt, _ := intType(t)
+	tt, err := intType(t)
+	if err != nil {
+		// a derived type like time.Duration, it is not listed in intType
+		if isUnsigned[T]() {
+			return 0
+		}
+		return -derivedMaxInt[T]() - 1
+	}
 	// Ensure [2] T exists in minValues because intLike Require [2]
 	return T(minValues[tt])
 }
@@ -91,8 +97,31 @@ func minInt[T intLike]() T {
 // Ensure [1] T exists in intType because intLike Require [1]
 func maxInt[T intLike]() T {
 	t := new(T)
-	// errors are ignored, because we know, that t is intLike
-	tt, _ := intType(t)
+	tt, err := intType(t)
+	if err != nil {
+		// a derived type like time.Duration, it is not listed in intType
+		return derivedMaxInt[T]()
+	}
 	// Ensure [2] T exists in minValues because intLike Require [2]
 	return T(maxValues[tt])
 }
+
+// isUnsigned returns true if T is unsigned: -1 is bigger than 0
+func isUnsigned[T intLike]() bool {
+	var zero T
+	return zero-1 > zero
+}
+
+// derivedMaxInt calculates a maximal value of T by setting all bits, except a sign bit of signed types:
+// 0b1 -> 0b11 -> ... -> 0b0111_1111, the next step 0b1111_1111 is negative (or the same for unsigned), so it stops.
+func derivedMaxInt[T intLike]() T {
+	if isUnsigned[T]() {
+		var zero T
+		return ^zero
+	}
+	m := T(1)
+	for next := m<<1 | 1; next > m; next = m<<1 | 1 {
+		m = next
+	}
+	return m
+}
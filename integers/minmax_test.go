@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"testing"
+	"time"
 )
 
 func Test_intType(t *testing.T) {
@@ -99,3 +100,15 @@ func Test_minInt(t *testing.T) {
 	minIntRun[uint32](t, "uint32", uint32(0))
 	minIntRun[uint64](t, "uint64", uint64(0))
 }
+
+type derivedInt8 int8
+type derivedUint16 uint16
+
+func Test_minMaxInt_Derived(t *testing.T) {
+	maxIntRun[time.Duration](t, "time.Duration", time.Duration(math.MaxInt64))
+	maxIntRun[derivedInt8](t, "derivedInt8", derivedInt8(math.MaxInt8))
+	maxIntRun[derivedUint16](t, "derivedUint16", derivedUint16(math.MaxUint16))
+	minIntRun[time.Duration](t, "time.Duration", time.Duration(math.MinInt64))
+	minIntRun[derivedInt8](t, "derivedInt8", derivedInt8(math.MinInt8))
+	minIntRun[derivedUint16](t, "derivedUint16", derivedUint16(0))
+}
@@ -0,0 +1,49 @@
+package segment_int
+
+import (
+	rng "github.com/pioniro/segment-go"
+	"strconv"
+)
+
+// Parse parses an int segment in interval notation, that is produced by String: [1;2), (-inf;5], (1, 2].
+// See segment.ParseBorders for details.
+func Parse[T intLike](s string) (*IntSegment[T], error) {
+	from, till, err := rng.ParseBorders(s, ParseInt[T])
+	if err != nil {
+		return nil, err
+	}
+	return NewIntSegment(from, till), nil
+}
+
+// MustParse is like Parse, but panics if a string cannot be parsed.
+func MustParse[T intLike](s string) *IntSegment[T] {
+	seg, err := Parse[T](s)
+	if err != nil {
+		panic(err)
+	}
+	return seg
+}
+
+// ParseInt parses a decimal representation of an integer value. It returns an error if a value does not fit into T.
+func ParseInt[T intLike](s string) (rng.Value[T], error) {
+	var zero T
+	// T is unsigned if -1 is bigger than 0
+	if zero-1 > zero {
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(T(v)) != v {
+			return nil, &strconv.NumError{Func: "ParseInt", Num: s, Err: strconv.ErrRange}
+		}
+		return Int(T(v)), nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if int64(T(v)) != v {
+		return nil, &strconv.NumError{Func: "ParseInt", Num: s, Err: strconv.ErrRange}
+	}
+	return Int(T(v)), nil
+}
@@ -0,0 +1,67 @@
+package segment_int
+
+import (
+	"errors"
+	. "github.com/pioniro/segment-go"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  *IntSegment[int64]
+	}{
+		{input: "[1;2)", want: NewIntSegment(NewIncluded(Int[int64](1)), NewExcluded(Int[int64](2)))},
+		{input: "(-inf, 5]", want: NewIntSegment(NewUnbound[int64](), NewIncluded(Int[int64](5)))},
+		{input: "[-9223372036854775808;+inf)", want: NewIntSegment(NewIncluded(Int[int64](math.MinInt64)), NewUnbound[int64]())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Parse[int64](tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Range(t *testing.T) {
+	tests := []struct {
+		input   string
+		parse   func(string) error
+		wantErr error
+	}{
+		{input: "[0;256)", parse: func(s string) error { _, err := Parse[uint8](s); return err }, wantErr: strconv.ErrRange},
+		{input: "[-1;255]", parse: func(s string) error { _, err := Parse[uint8](s); return err }, wantErr: strconv.ErrSyntax},
+		{input: "[-129;0]", parse: func(s string) error { _, err := Parse[int8](s); return err }, wantErr: strconv.ErrRange},
+		{input: "[0;18446744073709551615]", parse: func(s string) error { _, err := Parse[uint64](s); return err }},
+		{input: "[-128;127]", parse: func(s string) error { _, err := Parse[int8](s); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if err := tt.parse(tt.input); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	segments := []*IntSegment[uint64]{
+		NewIntSegment(NewIncluded(Int[uint64](math.MaxUint64)), NewUnbound[uint64]()),
+		NewIntSegment(NewUnbound[uint64](), NewExcluded(Int[uint64](0))),
+		NewIntSegment(NewExcluded(Int[uint64](1)), NewIncluded(Int[uint64](2))),
+	}
+	for _, s := range segments {
+		got := MustParse[uint64](s.String())
+		if !reflect.DeepEqual(got, s) {
+			t.Errorf("MustParse(%q) = %v", s.String(), got)
+		}
+	}
+}
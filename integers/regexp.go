@@ -0,0 +1,160 @@
+package segment_int
+
+import (
+	"github.com/pioniro/segment-go/ordered"
+	"strconv"
+	"strings"
+)
+
+// LeadingZeros defines, whether a regular expression of a segment matches numbers with leading zeros: 007.
+type LeadingZeros int
+
+const (
+	// ForbidZeros matches only canonical forms of numbers: 7, -7, 0.
+	ForbidZeros LeadingZeros = iota
+	// OptionalZeros matches numbers with any number of leading zeros: 7, 007, -007, 000.
+	OptionalZeros
+)
+
+// matchNothing is a pattern, that does not match any string.
+const matchNothing = `[^\x00-\x{10FFFF}]`
+
+// Regexp returns an anchored regular expression, that matches exactly decimal forms of integers of a segment:
+// [17;2049] -> ^(?:1[7-9]|[2-9]\d|[1-9]\d{2}|1\d{3}|20[0-4]\d)$
+// Unbound borders are min(T) and max(T), as in IsIncludes. An empty segment gives a pattern, that matches nothing.
+func (s *IntSegment[T]) Regexp(zeros LeadingZeros) string {
+	return SetRegexp(NewIntSegmentSet(s), zeros)
+}
+
+// SetRegexp returns an anchored regular expression, that matches exactly decimal forms of integers of a set, see IntSegment.Regexp.
+func SetRegexp[T intLike](set *ordered.SegmentSet[T], zeros LeadingZeros) string {
+	var negative, positive []string
+	for _, seg := range SetSegments(set) {
+		// unbound borders are min(T) and max(T)
+		from, till, ok := seg.bounds()
+		if !ok {
+			continue
+		}
+		var zero T
+		if from < zero {
+			// magnitudes of negative values are reversed: [-20;-5] -> 5..20
+			negative = append(negative, uintRange(magnitude(min(till, zero-1)), magnitude(from))...)
+		}
+		if till >= zero {
+			positive = append(positive, uintRange(uint64(max(from, zero)), uint64(till))...)
+		}
+	}
+	prefix := ""
+	if zeros == OptionalZeros {
+		prefix = "0*"
+	}
+	var alternatives []string
+	if len(negative) > 0 {
+		alternatives = append(alternatives, "-"+prefix+group(negative))
+	}
+	switch {
+	case len(positive) > 0 && prefix == "":
+		alternatives = append(alternatives, positive...)
+	case len(positive) > 0:
+		alternatives = append(alternatives, prefix+group(positive))
+	}
+	if len(alternatives) == 0 {
+		return "^" + matchNothing + "$"
+	}
+	return "^" + group(alternatives) + "$"
+}
+
+// magnitude returns an absolute value of a negative value, it works for min(T) too.
+func magnitude[T intLike](v T) uint64 {
+	return uint64(-(int64(v) + 1)) + 1
+}
+
+// uintRange returns alternatives of a pattern of numbers [lo;hi] without leading zeros.
+// Numbers are split by a number of digits, all full lengths between lo and hi are matched by one alternative.
+func uintRange(lo, hi uint64) []string {
+	loLen, hiLen := digits(lo), digits(hi)
+	if loLen == hiLen {
+		return sameLength(strconv.FormatUint(lo, 10), strconv.FormatUint(hi, 10))
+	}
+	// lo..99..9, 10..0..99..9 of full lengths, 10..0..hi
+	result := sameLength(strconv.FormatUint(lo, 10), strings.Repeat("9", loLen))
+	switch full := hiLen - loLen - 1; {
+	case full == 1:
+		result = append(result, `[1-9]\d`+repeat(loLen))
+	case full > 1:
+		result = append(result, `[1-9]\d{`+strconv.Itoa(loLen)+","+strconv.Itoa(hiLen-2)+"}")
+	}
+	return append(result, sameLength("1"+strings.Repeat("0", hiLen-1), strconv.FormatUint(hi, 10))...)
+}
+
+// sameLength returns alternatives of a pattern of numbers [lo;hi] with the same number of digits.
+// A common prefix is kept as is, then the first digit is split into a partial lower part, full middle digits and a partial upper part:
+// 17..99 -> 1[7-9], [2-9]\d; 1000..2049 -> 1\d{3}, 20[0-4]\d
+func sameLength(lo, hi string) []string {
+	if len(lo) == 1 {
+		return []string{digitClass(lo[0], hi[0])}
+	}
+	if lo[0] == hi[0] {
+		rest := sameLength(lo[1:], hi[1:])
+		for i, alt := range rest {
+			rest[i] = lo[:1] + alt
+		}
+		return rest
+	}
+	n := len(lo) - 1
+	first, last := lo[0], hi[0]
+	var result []string
+	// lo = a000 starts a full block, otherwise a is matched separately: a[rest;999]
+	if strings.Trim(lo[1:], "0") != "" {
+		for _, alt := range sameLength(lo[1:], strings.Repeat("9", n)) {
+			result = append(result, lo[:1]+alt)
+		}
+		first++
+	}
+	upper := !(strings.Trim(hi[1:], "9") == "")
+	if upper {
+		last--
+	}
+	if first <= last {
+		result = append(result, digitClass(first, last)+`\d`+repeat(n))
+	}
+	if upper {
+		for _, alt := range sameLength(strings.Repeat("0", n), hi[1:]) {
+			result = append(result, hi[:1]+alt)
+		}
+	}
+	return result
+}
+
+// digitClass returns a pattern of a digit from lo to hi: 7, [78], [7-9], \d.
+func digitClass(lo, hi byte) string {
+	switch {
+	case lo == hi:
+		return string(lo)
+	case lo == '0' && hi == '9':
+		return `\d`
+	case hi == lo+1:
+		return "[" + string(lo) + string(hi) + "]"
+	}
+	return "[" + string(lo) + "-" + string(hi) + "]"
+}
+
+// repeat returns a quantifier of n digits: "" for 1, {n} otherwise.
+func repeat(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "{" + strconv.Itoa(n) + "}"
+}
+
+// group joins alternatives into a non-capturing group, a single alternative is returned as is.
+func group(alternatives []string) string {
+	if len(alternatives) == 1 {
+		return alternatives[0]
+	}
+	return "(?:" + strings.Join(alternatives, "|") + ")"
+}
+
+func digits(v uint64) int {
+	return len(strconv.FormatUint(v, 10))
+}
@@ -0,0 +1,155 @@
+package segment_int
+
+import (
+	"fmt"
+	. "github.com/pioniro/segment-go"
+	"math"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestIntSegment_Regexp(t *testing.T) {
+	tests := []struct {
+		input string
+		zeros LeadingZeros
+		want  string
+	}{
+		{input: "[17;2049]", want: `^(?:1[7-9]|[2-9]\d|[1-9]\d{2}|1\d{3}|20[0-4]\d)$`},
+		{input: "[-5;300)", want: `^(?:-[1-5]|\d|[1-9]\d|[12]\d{2})$`},
+		{input: "[-5;300)", zeros: OptionalZeros, want: `^(?:-0*[1-5]|0*(?:\d|[1-9]\d|[12]\d{2}))$`},
+		{input: "[0;9]", want: `^\d$`},
+		{input: "[7;7]", zeros: OptionalZeros, want: `^0*7$`},
+		{input: "[1;100000]", want: `^(?:[1-9]|[1-9]\d{1,4}|100000)$`},
+		{input: "(1;2)", want: `^[^\x00-\x{10FFFF}]$`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := MustParse[int64](tt.input).Regexp(tt.zeros); got != tt.want {
+				t.Errorf("Regexp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// testRegexp checks a pattern of a segment against IsIncludes for all values of a domain and their zero padded forms.
+func testRegexp[T intLike](t *testing.T, s *IntSegment[T], domain []T) {
+	t.Helper()
+	strict := regexp.MustCompile(s.Regexp(ForbidZeros))
+	loose := regexp.MustCompile(s.Regexp(OptionalZeros))
+	for _, v := range domain {
+		want := s.IsIncludes(v)
+		str := Int(v).String()
+		if got := strict.MatchString(str); got != want {
+			t.Fatalf("%v: %s matches %s = %v, want %v", s, strict, str, got, want)
+		}
+		if got := loose.MatchString(str); got != want {
+			t.Fatalf("%v: %s matches %s = %v, want %v", s, loose, str, got, want)
+		}
+		padded := fmt.Sprintf("%05s", str)
+		if str[0] == '-' {
+			padded = "-" + fmt.Sprintf("%05s", str[1:])
+		}
+		if padded != str {
+			if strict.MatchString(padded) {
+				t.Fatalf("%v: %s matches %s", s, strict, padded)
+			}
+			if got := loose.MatchString(padded); got != want {
+				t.Fatalf("%v: %s matches %s = %v, want %v", s, loose, padded, got, want)
+			}
+		}
+	}
+	for _, junk := range []string{"", "-", "-0", "+1", " 1", "1 ", "0x1"} {
+		if strict.MatchString(junk) {
+			t.Fatalf("%v: %s matches %q", s, strict, junk)
+		}
+	}
+}
+
+func TestIntSegment_Regexp_Int16(t *testing.T) {
+	var domain []int16
+	for v := -1200; v <= 1200; v++ {
+		domain = append(domain, int16(v))
+	}
+	bounds := []Border[int16]{NewUnbound[int16]()}
+	for _, v := range []int16{-1000, -999, -101, -100, -99, -17, -10, -9, -1, 0, 1, 9, 10, 17, 99, 100, 101, 200, 299, 999, 1000, 1049} {
+		bounds = append(bounds, NewIncluded(Int(v)))
+	}
+	for _, v := range []int16{-100, 0, 100, 1000} {
+		bounds = append(bounds, NewExcluded(Int(v)))
+	}
+	for _, from := range bounds {
+		for _, till := range bounds {
+			testRegexp(t, NewIntSegment(from, till), domain)
+		}
+	}
+}
+
+func TestIntSegment_Regexp_Int8(t *testing.T) {
+	var domain []int8
+	for v := math.MinInt8; v <= math.MaxInt8; v++ {
+		domain = append(domain, int8(v))
+	}
+	for from := math.MinInt8; from <= math.MaxInt8; from += 3 {
+		for till := from - 1; till <= math.MaxInt8; till += 5 {
+			testRegexp(t, NewIntSegment(NewIncluded(Int(int8(from))), NewIncluded(Int(int8(till)))), domain)
+		}
+	}
+	testRegexp(t, NewIntSegment(NewUnbound[int8](), NewUnbound[int8]()), domain)
+}
+
+func TestIntSegment_Regexp_Limits(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		match   []string
+		noMatch []string
+	}{
+		{
+			name:    "int64",
+			pattern: NewIntSegment(NewUnbound[int64](), NewUnbound[int64]()).Regexp(ForbidZeros),
+			match:   []string{"-9223372036854775808", "9223372036854775807", "0", "-1"},
+			noMatch: []string{"-9223372036854775809", "9223372036854775808", "10000000000000000000"},
+		},
+		{
+			name:    "uint64",
+			pattern: MustParse[uint64]("[18446744073709551610;+inf)").Regexp(ForbidZeros),
+			match:   []string{"18446744073709551610", "18446744073709551615"},
+			noMatch: []string{"18446744073709551609", "18446744073709551616", "-1"},
+		},
+		{
+			name:    "uint8",
+			pattern: MustParse[uint8]("(-inf;+inf)").Regexp(ForbidZeros),
+			match:   []string{"0", "255"},
+			noMatch: []string{"256", "-0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			re := regexp.MustCompile(tt.pattern)
+			for _, s := range tt.match {
+				if !re.MatchString(s) {
+					t.Errorf("%s does not match %s", re, s)
+				}
+			}
+			for _, s := range tt.noMatch {
+				if re.MatchString(s) {
+					t.Errorf("%s matches %s", re, s)
+				}
+			}
+		})
+	}
+}
+
+func TestSetRegexp(t *testing.T) {
+	set := NewIntSegmentSet(MustParse[int]("[-20;-10]"), MustParse[int]("[5;15]"), MustParse[int]("(98;+inf)"))
+	re := regexp.MustCompile(SetRegexp(set, ForbidZeros))
+	for v := -100; v <= 1000; v++ {
+		if got, want := re.MatchString(strconv.Itoa(v)), set.Contains(v); got != want {
+			t.Fatalf("%s matches %d = %v, want %v", re, v, got, want)
+		}
+	}
+	if got := SetRegexp(NewIntSegmentSet[int](), OptionalZeros); got != `^[^\x00-\x{10FFFF}]$` {
+		t.Errorf("SetRegexp() = %v", got)
+	}
+}
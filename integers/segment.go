@@ -96,74 +96,11 @@ func (s *IntSegment[T]) Size() (T, error) {
 // If from is Unbound, then we must to start from [min(T); min(T) + size), then [min(T) + size; min(T) + size*2), etc.
 // If till is Unbound, then we must to end at [max(T) - size; max(T)] or [max(T); max(T)] (this is the only case where the right border will be Included)
 func (s *IntSegment[T]) Split(size T) gen.Generator[rng.SplitSegment[T]] {
-	var zero T
-	if size <= zero {
-		return func(yield gen.Yield[rng.SplitSegment[T]]) {}
-	}
-	// Since we can always cast a segment to a segment with included borders, and this greatly simplifies the algorithm (compares of values),
-	// we do this
-	//
-	// corner cases:
-	//	(A; MinInt) -> [A+1; MinInt-1], but size is less than 1, so we return empty gen
-	//	(MaxInt; A) -> [MaxInt+1; A-1], but size is less than 1, so we return empty gen
-	inc, err := mustToIncluded(s)
-	if err != nil {
-		return func(yield gen.Yield[rng.SplitSegment[T]]) {}
-	}
-	start := inc.From().Value().Value()
-	finish := inc.Till().Value().Value()
 	return func(yield gen.Yield[rng.SplitSegment[T]]) {
-		l := start
-		// overflow protection
-		// size is positive (size >= 1)
-		// so, r = l + size >= l. If not - overflow
-		r := l + size
-		if r <= l {
-			r = maxInt[T]()
-		}
-		for l <= finish {
-			// d is a current size of a chunk
-			// invariant:
-			// 1. d <= size
-			// 2. d > 0
-			// 3. if d less than size, then we throw [..;..] range and get out of the loop
-			var d T
-			// SIGNED: int8
-			// [-128; 5]
-			//  left = 5 - (-128) = -123 // overflow, left < 0, so d = size
-			// [1; 1]
-			//  left = 1 - 1 = 0 // no overflow, left >= 0, so d = min(left, size) = 0
-			// [-127; 5]
-			//   left = 5 - (-127) = -124 // overflow, left < 0, so d = size
-			// [-128; -127]
-			//   left = -127 - (-128) = 1 // no overflow, left > 0, so d = min(left, size)
-			// UNSIGNED: uint8
-			// [0; 5]
-			//   left = 5 - 0 = 5 // no overflow, left > 0, so d = min(left, size)
-			// [1; 5]
-			//   left = 5 - 1 = 4 // no overflow, left > 0, so d = min(left, size)
-			// [0; 255]
-			//   left = 255 - 0 = 255 // no overflow, left > 0, so d = min(left, size)
-			// so, checking for overflow is not necessary, we can rely to left < zero
-			left := finish - l
-			if left < zero {
-				d = size
-			} else {
-				d = min(size, left)
-			}
-			// invariant 1: d <= size
-			// invariant 2: d > 0
-			// overflow is impossible: d < max - l, d > 0
-			r = l + d
-			// or d < size (invariant 3)
-			if d != size {
-				yield(NewIntSegment(rng.NewIncluded(Int(l)), rng.NewIncluded(Int(r))), nil)
+		for chunk := range s.Chunks(size) {
+			if !yield(chunk, nil) {
 				return
 			}
-			if !yield(NewIntSegment(rng.NewIncluded(Int(l)), rng.NewExcluded(Int(r))), nil) {
-				return
-			}
-			l = r
 		}
 	}
 }
@@ -191,7 +128,8 @@ func mustToIncluded[T intLike](s *IntSegment[T]) (*IntSegment[T], error) {
 }
 
 func (s *IntSegment[T]) IsEmpty() bool {
-	if s.From().IsUnbound() || s.Till().IsUnbound() {
+	// (MaxInt; inf) and (inf; MinInt) are empty, so only (inf; inf) is not empty for sure
+	if s.From().IsUnbound() && s.Till().IsUnbound() {
 		return false
 	}
 	size, err := s.Size()
@@ -204,20 +142,20 @@ func (s *IntSegment[T]) IsEmpty() bool {
 
 func (s *IntSegment[T]) Iterate() gen.Generator[T] {
 	return func(yield gen.Yield[T]) {
-		// Since we can always cast an int segment to a segment with included borders, and this greatly simplifies the algorithm (compares of values),
-		// we do this
-		//
-		// corner cases:
-		//	(A; MinInt) -> [A+1; MinInt-1], but size is less than 1, so we return empty gen
-		//	(MaxInt; A) -> [MaxInt+1; A-1], but size is less than 1, so we return empty gen
-		inc, err := mustToIncluded(s)
-		if err != nil {
-			return
+		for v := range s.All() {
+			if !yield(v, nil) {
+				return
+			}
 		}
-		start := inc.From().Value().Value()
-		finish := inc.Till().Value().Value()
-		for i := start; i <= finish; i++ {
-			if !yield(i, nil) {
+	}
+}
+
+// IterateStep returns a generator of values of a segment with a given step, negative step iterates from the till border down.
+// See Step for details.
+func (s *IntSegment[T]) IterateStep(step int64) gen.Generator[T] {
+	return func(yield gen.Yield[T]) {
+		for v := range s.Step(step) {
+			if !yield(v, nil) {
 				return
 			}
 		}
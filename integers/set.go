@@ -0,0 +1,17 @@
+package segment_int
+
+import "github.com/pioniro/segment-go/ordered"
+
+// NewIntSegmentSet creates a new set from given segments, merging overlapping and adjacent ones: [1;3], [4;6] -> {[1;6]}
+func NewIntSegmentSet[T intLike](segments ...*IntSegment[T]) *ordered.SegmentSet[T] {
+	result := make([]*ordered.OrderedSegment[T], len(segments))
+	for i, seg := range segments {
+		result[i] = seg.OrderedSegment
+	}
+	return ordered.NewSegmentSet(result...)
+}
+
+// SetSegments returns segments of a set as int segments, canonicalised to included borders.
+func SetSegments[T intLike](s *ordered.SegmentSet[T]) []*IntSegment[T] {
+	return canonicalAll(s.Segments())
+}
@@ -0,0 +1,47 @@
+package segment_int
+
+import (
+	. "github.com/pioniro/segment-go"
+	"reflect"
+	"testing"
+)
+
+func TestNewIntSegmentSet(t *testing.T) {
+	type testCase[T intLike] struct {
+		name     string
+		segments []*IntSegment[T]
+		want     []*IntSegment[T]
+	}
+	tests := []testCase[int64]{
+		{
+			name: "[1;3] [4;6]",
+			segments: []*IntSegment[int64]{
+				NewIntSegment(NewIncluded(Int[int64](1)), NewIncluded(Int[int64](3))),
+				NewIntSegment(NewIncluded(Int[int64](4)), NewIncluded(Int[int64](6))),
+			},
+			want: []*IntSegment[int64]{
+				NewIntSegment(NewIncluded(Int[int64](1)), NewIncluded(Int[int64](6))),
+			},
+		},
+		{
+			name: "(8;10) [1;3) (3;5)",
+			segments: []*IntSegment[int64]{
+				NewIntSegment(NewExcluded(Int[int64](8)), NewExcluded(Int[int64](10))),
+				NewIntSegment(NewIncluded(Int[int64](1)), NewExcluded(Int[int64](3))),
+				NewIntSegment(NewExcluded(Int[int64](3)), NewExcluded(Int[int64](5))),
+			},
+			want: []*IntSegment[int64]{
+				NewIntSegment(NewIncluded(Int[int64](1)), NewIncluded(Int[int64](2))),
+				NewIntSegment(NewIncluded(Int[int64](4)), NewIncluded(Int[int64](4))),
+				NewIntSegment(NewIncluded(Int[int64](9)), NewIncluded(Int[int64](9))),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetSegments(NewIntSegmentSet(tt.segments...)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewIntSegmentSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
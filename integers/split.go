@@ -0,0 +1,144 @@
+package segment_int
+
+import (
+	gen "github.com/pioniro/generator-go"
+	rng "github.com/pioniro/segment-go"
+	"iter"
+	"math/big"
+)
+
+// SplitN splits a segment into exactly n contiguous parts, whose sizes differ by at most one:
+// [0; 10] -> [0; 3), [3; 7), [7; 10]. Bounds follow Split: all parts are [a; b), the last part with values is [c; d].
+// If n is bigger than a size of a segment, then some parts are empty: (d; d].
+// If n less than 1 or a segment is empty, then empty gen will be returned. Unbound borders are min(T) and max(T), as in Split.
+func (s *IntSegment[T]) SplitN(n int) gen.Generator[rng.SplitSegment[T]] {
+	return func(yield gen.Yield[rng.SplitSegment[T]]) {
+		for part := range s.ChunksN(n) {
+			if !yield(part, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ChunksN returns an iterator over n parts of a segment, see SplitN.
+func (s *IntSegment[T]) ChunksN(n int) iter.Seq[*IntSegment[T]] {
+	if n < 1 {
+		return func(yield func(*IntSegment[T]) bool) {}
+	}
+	weights := make([]uint, n)
+	for i := range weights {
+		weights[i] = 1
+	}
+	return s.ChunksWeighted(weights)
+}
+
+// SplitWeighted splits a segment into len(weights) contiguous parts with sizes proportional to weights:
+// [0; 99] with weights 1, 3 -> [0; 25), [25; 99]. Part i has floor(size*(w0+...+wi)/W) - floor(size*(w0+...+w(i-1))/W) values,
+// where W is a sum of weights, so sizes differ from exact proportions by less than one and parts with zero weight are empty.
+// Empty parts keep their positions: [a; a) before values, (d; d] after them. Bounds and unbound borders follow SplitN.
+// If a sum of weights is zero or a segment is empty, then empty gen will be returned.
+func (s *IntSegment[T]) SplitWeighted(weights []uint) gen.Generator[rng.SplitSegment[T]] {
+	return func(yield gen.Yield[rng.SplitSegment[T]]) {
+		for part := range s.ChunksWeighted(weights) {
+			if !yield(part, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ChunksWeighted returns an iterator over parts of a segment with sizes proportional to weights, see SplitWeighted.
+func (s *IntSegment[T]) ChunksWeighted(weights []uint) iter.Seq[*IntSegment[T]] {
+	return func(yield func(*IntSegment[T]) bool) {
+		total := new(big.Int)
+		for _, w := range weights {
+			total.Add(total, new(big.Int).SetUint64(uint64(w)))
+		}
+		start, finish, ok := s.bounds()
+		if !ok || total.Sign() == 0 {
+			return
+		}
+		// size can be 2^64 for (-inf; +inf) of int64 and uint64, so it is a big.Int
+		// uint64 of a negative value wraps around, but the difference is still correct modulo 2^64
+		size := new(big.Int).SetUint64(uint64(finish) - uint64(start))
+		size.Add(size, big.NewInt(1))
+		// offsets from start: a part is [prev; end)
+		cum := new(big.Int)
+		prev := new(big.Int)
+		for _, w := range weights {
+			cum.Add(cum, new(big.Int).SetUint64(uint64(w)))
+			end := new(big.Int).Mul(size, cum)
+			end.Quo(end, total)
+			if !yield(part(start, finish, prev, end, size)) {
+				return
+			}
+			prev = end
+		}
+	}
+}
+
+// part returns a part [start+from; start+till) of a segment [start; finish] with a given size.
+// A part, that ends at the end of a segment, is [start+from; finish], and an empty one is [start+from; start+from) or (finish; finish].
+func part[T intLike](start, finish T, from, till, size *big.Int) *IntSegment[T] {
+	// offsets less than size fit into uint64, and start+offset is in [start; finish], so the conversion to T is exact
+	at := func(offset *big.Int) rng.Value[T] {
+		return Int(T(uint64(start) + offset.Uint64()))
+	}
+	switch {
+	case from.Cmp(size) == 0:
+		return NewIntSegment(rng.NewExcluded(Int(finish)), rng.NewIncluded(Int(finish)))
+	case from.Cmp(till) == 0:
+		return NewIntSegment(rng.NewIncluded(at(from)), rng.NewExcluded(at(from)))
+	case till.Cmp(size) == 0:
+		return NewIntSegment(rng.NewIncluded(at(from)), rng.NewIncluded(Int(finish)))
+	}
+	return NewIntSegment(rng.NewIncluded(at(from)), rng.NewExcluded(at(till)))
+}
+
+// SplitAligned splits a segment into chunks, whose borders are multiples of a given size:
+// [7; 35] by 10 -> [7; 10), [10; 20), [20; 30), [30; 35]. Only the first and the last chunks can be smaller than size.
+// Bounds, unbound borders and overflow safety are the same as in Split. See SplitAlignedTo for other origins.
+func (s *IntSegment[T]) SplitAligned(size T) gen.Generator[rng.SplitSegment[T]] {
+	var zero T
+	return s.SplitAlignedTo(size, zero)
+}
+
+// SplitAlignedTo is like SplitAligned, but borders of chunks are origin + k*size: [7; 35] by 10 to 5 -> [7; 15), [15; 25), [25; 35), [35; 35].
+func (s *IntSegment[T]) SplitAlignedTo(size, origin T) gen.Generator[rng.SplitSegment[T]] {
+	return func(yield gen.Yield[rng.SplitSegment[T]]) {
+		for chunk := range s.ChunksAlignedTo(size, origin) {
+			if !yield(chunk, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ChunksAligned returns an iterator over chunks of a segment aligned to multiples of a given size, see SplitAligned.
+func (s *IntSegment[T]) ChunksAligned(size T) iter.Seq[*IntSegment[T]] {
+	var zero T
+	return s.ChunksAlignedTo(size, zero)
+}
+
+// ChunksAlignedTo returns an iterator over chunks of a segment aligned to origin + k*size, see SplitAlignedTo.
+func (s *IntSegment[T]) ChunksAlignedTo(size, origin T) iter.Seq[*IntSegment[T]] {
+	var zero T
+	if size <= zero {
+		return func(yield func(*IntSegment[T]) bool) {}
+	}
+	return s.chunks(size, func(start T) T {
+		// start - origin can overflow T, so the distance to the next border is calculated with big.Int:
+		// size - (start - origin) mod size, it is from 1 to size
+		rem := new(big.Int).Sub(bigInt(start), bigInt(origin))
+		rem.Mod(rem, bigInt(size))
+		return size - T(rem.Uint64())
+	})
+}
+
+func bigInt[T intLike](v T) *big.Int {
+	if isUnsigned[T]() {
+		return new(big.Int).SetUint64(uint64(v))
+	}
+	return big.NewInt(int64(v))
+}
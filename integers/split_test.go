@@ -0,0 +1,163 @@
+package segment_int
+
+import (
+	. "github.com/pioniro/segment-go"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func joinParts[T intLike](parts []*IntSegment[T]) string {
+	s := make([]string, len(parts))
+	for i, p := range parts {
+		s[i] = p.String()
+	}
+	return strings.Join(s, " ")
+}
+
+func TestIntSegment_SplitN(t *testing.T) {
+	tests := []struct {
+		input string
+		n     int
+		want  string
+	}{
+		{input: "[0;10]", n: 3, want: "[0;3) [3;7) [7;10]"},
+		{input: "[0;10)", n: 2, want: "[0;5) [5;9]"},
+		{input: "(0;3)", n: 4, want: "[1;1) [1;2) [2;2) [2;2]"},
+		{input: "[5;5]", n: 1, want: "[5;5]"},
+		{input: "(-inf;+inf)", n: 2, want: "[-128;0) [0;127]"},
+		{input: "(-inf;+inf)", n: 3, want: "[-128;-43) [-43;42) [42;127]"},
+		{input: "[0;10]", n: 0, want: ""},
+		{input: "(1;2)", n: 3, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := joinParts(slices.Collect(MustParse[int8](tt.input).ChunksN(tt.n))); got != tt.want {
+				t.Errorf("ChunksN(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntSegment_SplitN_Sizes(t *testing.T) {
+	for _, input := range []string{"[0;10]", "[-128;127]", "[3;4]", "(-100;100)"} {
+		s := MustParse[int8](input)
+		for n := 1; n <= 20; n++ {
+			var sizes []int
+			var values []int8
+			for part := range s.ChunksN(n) {
+				size := 0
+				for v := range part.All() {
+					values = append(values, v)
+					size++
+				}
+				sizes = append(sizes, size)
+			}
+			if len(sizes) != n {
+				t.Fatalf("ChunksN(%s, %d) has %d parts", input, n, len(sizes))
+			}
+			if slices.Max(sizes)-slices.Min(sizes) > 1 {
+				t.Errorf("ChunksN(%s, %d) sizes = %v", input, n, sizes)
+			}
+			if !slices.Equal(values, slices.Collect(s.All())) {
+				t.Errorf("ChunksN(%s, %d) values = %v", input, n, values)
+			}
+		}
+	}
+}
+
+func TestIntSegment_SplitN_Full(t *testing.T) {
+	s := NewIntSegment(NewUnbound[uint64](), NewUnbound[uint64]())
+	got := joinParts(slices.Collect(s.ChunksN(2)))
+	if want := "[0;9223372036854775808) [9223372036854775808;18446744073709551615]"; got != want {
+		t.Errorf("ChunksN() = %v, want %v", got, want)
+	}
+	i := NewIntSegment(NewUnbound[int64](), NewUnbound[int64]())
+	got = joinParts(slices.Collect(i.ChunksN(1)))
+	if want := "[-9223372036854775808;9223372036854775807]"; got != want {
+		t.Errorf("ChunksN() = %v, want %v", got, want)
+	}
+	var parts []SplitSegment[int64]
+	i.SplitN(4)(func(part SplitSegment[int64], err error) bool {
+		parts = append(parts, part)
+		return err == nil && len(parts) < 2
+	})
+	if len(parts) != 2 || parts[1].From().Value().Value() != math.MinInt64/2 {
+		t.Errorf("SplitN() = %v", parts)
+	}
+}
+
+func TestIntSegment_SplitWeighted(t *testing.T) {
+	tests := []struct {
+		input   string
+		weights []uint
+		want    string
+	}{
+		{input: "[0;99]", weights: []uint{1, 3}, want: "[0;25) [25;99]"},
+		{input: "[0;9]", weights: []uint{1, 0, 1}, want: "[0;5) [5;5) [5;9]"},
+		{input: "[0;9]", weights: []uint{1, 1, 0}, want: "[0;5) [5;9] (9;9]"},
+		{input: "[0;9]", weights: []uint{0, 0}, want: ""},
+		{input: "[0;9]", weights: nil, want: ""},
+		{input: "[0;2]", weights: []uint{math.MaxUint, math.MaxUint, 1}, want: "[0;1) [1;2) [2;2]"},
+	}
+	for _, tt := range tests {
+		if got := joinParts(slices.Collect(MustParse[int64](tt.input).ChunksWeighted(tt.weights))); got != tt.want {
+			t.Errorf("ChunksWeighted(%s, %v) = %v, want %v", tt.input, tt.weights, got, tt.want)
+		}
+	}
+	var parts []SplitSegment[uint8]
+	MustParse[uint8]("[0;+inf)").SplitWeighted([]uint{2, 1, 1})(func(part SplitSegment[uint8], err error) bool {
+		parts = append(parts, part)
+		return err == nil
+	})
+	if len(parts) != 3 || parts[2].(*IntSegment[uint8]).String() != "[192;255]" {
+		t.Errorf("SplitWeighted() = %v", parts)
+	}
+}
+
+func TestIntSegment_SplitAligned(t *testing.T) {
+	tests := []struct {
+		input  string
+		size   int8
+		origin int8
+		want   string
+	}{
+		{input: "[7;35]", size: 10, want: "[7;10) [10;20) [20;30) [30;35]"},
+		{input: "[7;35]", size: 10, origin: 5, want: "[7;15) [15;25) [25;35) [35;35]"},
+		{input: "[10;30)", size: 10, want: "[10;20) [20;29]"},
+		{input: "[-15;5]", size: 10, want: "[-15;-10) [-10;0) [0;5]"},
+		{input: "[-15;5]", size: 10, origin: -127, want: "[-15;-7) [-7;3) [3;5]"},
+		{input: "[3;5]", size: 100, want: "[3;5]"},
+		{input: "(-inf;+inf)", size: 100, want: "[-128;-100) [-100;0) [0;100) [100;127]"},
+		{input: "[120;+inf)", size: 100, origin: 127, want: "[120;127) [127;127]"},
+		{input: "(-inf;-120]", size: 127, origin: 127, want: "[-128;-127) [-127;-120]"},
+		{input: "[1;5]", size: 0, want: ""},
+		{input: "(1;2)", size: 1, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := joinParts(slices.Collect(MustParse[int8](tt.input).ChunksAlignedTo(tt.size, tt.origin)))
+			if got != tt.want {
+				t.Errorf("ChunksAlignedTo(%d, %d) = %v, want %v", tt.size, tt.origin, got, tt.want)
+			}
+		})
+	}
+	var parts []SplitSegment[uint64]
+	MustParse[uint64]("[18446744073709551610;+inf)").SplitAligned(4)(func(part SplitSegment[uint64], err error) bool {
+		parts = append(parts, part)
+		return err == nil
+	})
+	if got := joinParts([]*IntSegment[uint64]{parts[0].(*IntSegment[uint64]), parts[1].(*IntSegment[uint64])}); len(parts) != 2 ||
+		got != "[18446744073709551610;18446744073709551612) [18446744073709551612;18446744073709551615]" {
+		t.Errorf("SplitAligned() = %v", parts)
+	}
+	full := NewIntSegment(NewUnbound[int64](), NewUnbound[int64]())
+	var first []*IntSegment[int64]
+	for chunk := range full.ChunksAlignedTo(math.MaxInt64, math.MinInt64) {
+		first = append(first, chunk)
+	}
+	if got := joinParts(first); got != "[-9223372036854775808;-1) [-1;9223372036854775806) [9223372036854775806;9223372036854775807]" {
+		t.Errorf("ChunksAlignedTo() = %v", got)
+	}
+}
@@ -20,15 +20,10 @@ func (v *intValue[T]) Value() T {
 }
 
 func (v *intValue[T]) String() string {
-	typ, _ := intType(v.value)
-	switch typ {
-	case "uint64":
-		fallthrough
-	case "uint32":
+	if isUnsigned[T]() {
 		return strconv.FormatUint(uint64(v.value), 10)
-	default:
-		return strconv.FormatInt(int64(v.value), 10)
 	}
+	return strconv.FormatInt(int64(v.value), 10)
 }
 
 // Next returns a next value of a given value. Or an error if it is not possible to calculate a next value (overflow for example).
@@ -5,6 +5,7 @@ import (
 	"math"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestInt(t *testing.T) {
@@ -177,3 +178,17 @@ func Test_intValue_Value(t *testing.T) {
 		})
 	}
 }
+
+func Test_intValue_String_Derived(t *testing.T) {
+	type big uint64
+	if got := Int(big(math.MaxUint64)).String(); got != "18446744073709551615" {
+		t.Errorf("String() = %s, want 18446744073709551615", got)
+	}
+	if got := Int(time.Duration(-5)).String(); got != "-5" {
+		t.Errorf("String() = %s, want -5", got)
+	}
+	s := NewIntSegment(rng.NewUnbound[time.Duration](), rng.NewIncluded(Int(time.Duration(math.MinInt64+1))))
+	if got, err := s.Size(); err != nil || got != 2 {
+		t.Errorf("Size() = %v, %v, want 2", got, err)
+	}
+}
@@ -0,0 +1,91 @@
+package segment_int
+
+import (
+	gen "github.com/pioniro/generator-go"
+	rng "github.com/pioniro/segment-go"
+	"iter"
+)
+
+// Window is a window of a segment with its index, see IntSegment.Windows.
+type Window[T intLike] struct {
+	Index   uint64
+	Segment *IntSegment[T]
+}
+
+// Windows returns a generator of windows of a given width, that start every stride values from the first value of a segment:
+// [0; 9] with width 4 and stride 2 -> 0: [0; 4), 1: [2; 6), 2: [4; 8), 3: [6; 9], 4: [8; 9].
+// Windows overlap if stride < width (hopping), follow each other if stride == width (tumbling, the same as Split)
+// and leave gaps if stride > width. Windows are clipped to a segment, a clipped window ends with an Included border.
+// If width or stride less than 1 or a segment is empty, then empty gen will be returned.
+// Unbound borders are min(T) and max(T), as in Split, and indexes never overflow, because there are at most 2^64 windows.
+func (s *IntSegment[T]) Windows(width, stride T) gen.Generator[Window[T]] {
+	return func(yield gen.Yield[Window[T]]) {
+		for i, w := range s.AllWindows(width, stride) {
+			if !yield(Window[T]{Index: i, Segment: w}, nil) {
+				return
+			}
+		}
+	}
+}
+
+// AllWindows returns an iterator over indexes and windows of a segment, see Windows.
+func (s *IntSegment[T]) AllWindows(width, stride T) iter.Seq2[uint64, *IntSegment[T]] {
+	return func(yield func(uint64, *IntSegment[T]) bool) {
+		var zero T
+		start, finish, ok := s.bounds()
+		if !ok || width <= zero || stride <= zero {
+			return
+		}
+		span := uint64(finish) - uint64(start)
+		for i, offset := uint64(0), uint64(0); ; i++ {
+			if !yield(i, window(start, span, offset, uint64(width))) {
+				return
+			}
+			// the next window starts after the end of a segment or offset overflows
+			if uint64(stride) > span-offset {
+				return
+			}
+			offset += uint64(stride)
+		}
+	}
+}
+
+// WindowsAt returns an iterator over indexes and windows of a segment, that contain a point, see Windows.
+// Indexes are calculated directly, so it does not iterate over previous windows.
+func (s *IntSegment[T]) WindowsAt(point, width, stride T) iter.Seq2[uint64, *IntSegment[T]] {
+	return func(yield func(uint64, *IntSegment[T]) bool) {
+		var zero T
+		start, finish, ok := s.bounds()
+		if !ok || width <= zero || stride <= zero || point < start || point > finish {
+			return
+		}
+		span := uint64(finish) - uint64(start)
+		// a window i contains a point at distance d, if i*stride <= d <= i*stride + width - 1
+		d := uint64(point) - uint64(start)
+		w, st := uint64(width), uint64(stride)
+		first, last := uint64(0), d/st
+		if d >= w {
+			first = (d-w)/st + 1
+		}
+		// first > last if a point is in a gap between windows
+		if first > last {
+			return
+		}
+		// i == last is checked before i++, so i never overflows
+		for i := first; ; i++ {
+			if !yield(i, window(start, span, i*st, w)) || i == last {
+				return
+			}
+		}
+	}
+}
+
+// window returns a window [start+offset; start+offset+width) clipped to [start; start+span].
+func window[T intLike](start T, span, offset, width uint64) *IntSegment[T] {
+	l := T(uint64(start) + offset)
+	// the window reaches the end of a segment
+	if width-1 >= span-offset {
+		return NewIntSegment(rng.NewIncluded(Int(l)), rng.NewIncluded(Int(T(uint64(start)+span))))
+	}
+	return NewIntSegment(rng.NewIncluded(Int(l)), rng.NewExcluded(Int(T(uint64(start)+offset+width))))
+}
@@ -0,0 +1,92 @@
+package segment_int
+
+import (
+	"fmt"
+	. "github.com/pioniro/segment-go"
+	"math"
+	"strings"
+	"testing"
+)
+
+func joinWindows[T intLike](windows func(func(uint64, *IntSegment[T]) bool)) string {
+	var parts []string
+	for i, w := range windows {
+		parts = append(parts, fmt.Sprintf("%d:%v", i, w))
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestIntSegment_Windows(t *testing.T) {
+	tests := []struct {
+		input         string
+		width, stride int8
+		want          string
+	}{
+		{input: "[0;9]", width: 4, stride: 2, want: "0:[0;4) 1:[2;6) 2:[4;8) 3:[6;9] 4:[8;9]"},
+		{input: "[0;9]", width: 5, stride: 5, want: "0:[0;5) 1:[5;9]"},
+		{input: "[0;9]", width: 2, stride: 4, want: "0:[0;2) 1:[4;6) 2:[8;9]"},
+		{input: "(0;3)", width: 10, stride: 1, want: "0:[1;2] 1:[2;2]"},
+		{input: "[120;+inf)", width: 4, stride: 3, want: "0:[120;124) 1:[123;127) 2:[126;127]"},
+		{input: "(-inf;+inf)", width: 127, stride: 127, want: "0:[-128;-1) 1:[-1;126) 2:[126;127]"},
+		{input: "[0;9]", width: 0, stride: 1, want: ""},
+		{input: "[0;9]", width: 1, stride: -1, want: ""},
+		{input: "(1;2)", width: 1, stride: 1, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := joinWindows(MustParse[int8](tt.input).AllWindows(tt.width, tt.stride)); got != tt.want {
+				t.Errorf("AllWindows(%d, %d) = %v, want %v", tt.width, tt.stride, got, tt.want)
+			}
+		})
+	}
+	var got []Window[int8]
+	MustParse[int8]("[0;9]").Windows(3, 3)(func(w Window[int8], err error) bool {
+		got = append(got, w)
+		return err == nil && len(got) < 2
+	})
+	if len(got) != 2 || got[1].Index != 1 || got[1].Segment.String() != "[3;6)" {
+		t.Errorf("Windows() = %v", got)
+	}
+}
+
+func TestIntSegment_WindowsAt(t *testing.T) {
+	for _, input := range []string{"[0;9]", "[-128;127]", "(-5;17)", "[100;+inf)"} {
+		s := MustParse[int8](input)
+		for width := int8(1); width <= 7; width++ {
+			for stride := int8(1); stride <= 7; stride++ {
+				for p := math.MinInt8; p <= math.MaxInt8; p++ {
+					var want []string
+					for i, w := range s.AllWindows(width, stride) {
+						if w.IsIncludes(int8(p)) {
+							want = append(want, fmt.Sprintf("%d:%v", i, w))
+						}
+					}
+					if got := joinWindows(s.WindowsAt(int8(p), width, stride)); got != strings.Join(want, " ") {
+						t.Fatalf("WindowsAt(%s, %d, %d, %d) = %v, want %v", input, p, width, stride, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestIntSegment_Windows_Full(t *testing.T) {
+	s := NewIntSegment(NewUnbound[uint64](), NewUnbound[uint64]())
+	got := joinWindows(s.WindowsAt(math.MaxUint64, 3, 1))
+	want := "18446744073709551613:[18446744073709551613;18446744073709551615] " +
+		"18446744073709551614:[18446744073709551614;18446744073709551615] " +
+		"18446744073709551615:[18446744073709551615;18446744073709551615]"
+	if got != want {
+		t.Errorf("WindowsAt() = %v, want %v", got, want)
+	}
+	var n int
+	for i, w := range NewIntSegment(NewUnbound[int64](), NewUnbound[int64]()).AllWindows(math.MaxInt64, math.MaxInt64) {
+		n++
+		if i == 2 && w.String() != "[9223372036854775806;9223372036854775807]" {
+			t.Errorf("AllWindows() = %v", w)
+		}
+	}
+	if n != 3 {
+		t.Errorf("len(AllWindows()) = %d, want 3", n)
+	}
+}
@@ -0,0 +1,36 @@
+// Package runs contains helpers to find maximal runs of equal keys without visiting every value of a run.
+package runs
+
+// End returns the last offset of a run, that starts at start and ends not later than span.
+// same(start) is true, and same must be true for offsets of a run and false after it.
+// It calls same O(log(length of a run)) times: it looks ahead with an exponential search and
+// then finds the end with a binary search. Offsets never exceed span, so they never overflow.
+func End[O ~int64 | ~uint64](start, span O, same func(offset O) bool) O {
+	// exponential search: same(lo) is true, same(hi) is false
+	lo, hi := start, span
+	for step := O(1); ; {
+		if lo == span {
+			return span
+		}
+		step = min(step, span-lo)
+		if !same(lo + step) {
+			hi = lo + step
+			break
+		}
+		lo += step
+		// offsets are not negative, so lo >= step and step*2 <= step+span-lo <= span and never overflows
+		if step <= span-lo {
+			step *= 2
+		}
+	}
+	// binary search of the last offset of a run in [lo; hi)
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if same(mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
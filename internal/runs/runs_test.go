@@ -0,0 +1,40 @@
+package runs
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEnd(t *testing.T) {
+	tests := []struct {
+		start, span, last uint64
+	}{
+		{start: 0, span: 0, last: 0},
+		{start: 0, span: 100, last: 0},
+		{start: 0, span: 100, last: 37},
+		{start: 5, span: 100, last: 64},
+		{start: 5, span: 100, last: 100},
+		{start: 0, span: math.MaxUint64, last: math.MaxUint64 - 1},
+		{start: 0, span: math.MaxUint64, last: math.MaxUint64},
+		{start: math.MaxUint64, span: math.MaxUint64, last: math.MaxUint64},
+	}
+	for _, tt := range tests {
+		calls := 0
+		got := End(tt.start, tt.span, func(o uint64) bool {
+			calls++
+			if o < tt.start || o > tt.span {
+				t.Fatalf("End(%d, %d) called same(%d)", tt.start, tt.span, o)
+			}
+			return o <= tt.last
+		})
+		if got != tt.last {
+			t.Errorf("End(%d, %d) = %d, want %d", tt.start, tt.span, got, tt.last)
+		}
+		if calls > 2*64+2 {
+			t.Errorf("End(%d, %d) called same %d times", tt.start, tt.span, calls)
+		}
+	}
+	if got := End[int64](0, math.MaxInt64, func(o int64) bool { return o < 1<<40 }); got != 1<<40-1 {
+		t.Errorf("End() of int64 = %d, want %d", got, int64(1<<40-1))
+	}
+}
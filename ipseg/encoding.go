@@ -0,0 +1,44 @@
+package ipseg
+
+import (
+	"github.com/pioniro/segment-go"
+	"net/netip"
+)
+
+func init() {
+	segment.RegisterDefaultValue(Addr, ParseAddr)
+}
+
+// MarshalJSON encodes a segment as an object, see segment.MarshalSegmentJSON.
+func (s *IPSegment) MarshalJSON() ([]byte, error) {
+	return segment.MarshalSegmentJSON[netip.Addr](s)
+}
+
+// UnmarshalJSON decodes a segment from an object or from a string in interval notation.
+func (s *IPSegment) UnmarshalJSON(data []byte) error {
+	from, till, err := segment.UnmarshalSegmentJSONRegistered[netip.Addr](data)
+	if err != nil {
+		return err
+	}
+	seg, err := NewIPSegment(from, till)
+	if err != nil {
+		return err
+	}
+	*s = *seg
+	return nil
+}
+
+// MarshalText encodes a segment in interval notation, see String.
+func (s *IPSegment) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a segment in interval notation: [10.0.0.0;10.0.0.255].
+func (s *IPSegment) UnmarshalText(text []byte) error {
+	seg, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*s = *seg
+	return nil
+}
@@ -0,0 +1,24 @@
+package ipseg
+
+import (
+	"github.com/pioniro/segment-go"
+)
+
+// Parse parses an IP segment in interval notation, that is produced by String:
+// [10.0.0.0;10.0.0.255], (2001:db8::;+inf). See NewIPSegment for errors.
+func Parse(s string) (*IPSegment, error) {
+	from, till, err := segment.ParseBorders(s, ParseAddr)
+	if err != nil {
+		return nil, err
+	}
+	return NewIPSegment(from, till)
+}
+
+// MustParse is like Parse, but panics if a string cannot be parsed.
+func MustParse(s string) *IPSegment {
+	seg, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return seg
+}
@@ -0,0 +1,55 @@
+package ipseg
+
+import (
+	"github.com/pioniro/segment-go"
+	"math/big"
+	"net/netip"
+)
+
+// FromPrefix returns a segment of all addresses of a prefix: 10.0.0.0/24 -> [10.0.0.0;10.0.0.255].
+// Host bits of a prefix are ignored: 10.0.0.1/24 is the same as 10.0.0.0/24.
+func FromPrefix(p netip.Prefix) (*IPSegment, error) {
+	if !p.IsValid() {
+		return nil, ErrInvalidAddr
+	}
+	p = p.Masked()
+	first := p.Addr()
+	hosts := new(big.Int).Lsh(big.NewInt(1), uint(first.BitLen()-p.Bits()))
+	last := fromInt(hosts.Add(hosts, toInt(first)).Sub(hosts, big.NewInt(1)), first.BitLen())
+	return NewIPSegment(segment.NewIncluded(Addr(first)), segment.NewIncluded(Addr(last)))
+}
+
+// Prefixes returns the minimal list of CIDR prefixes, that covers exactly the addresses of a segment:
+// [10.0.0.1;10.0.0.6] -> 10.0.0.1/32, 10.0.0.2/31, 10.0.0.4/31, 10.0.0.6/32. An empty segment has no prefixes.
+func (s *IPSegment) Prefixes() []netip.Prefix {
+	first, last, ok := s.bounds()
+	if !ok {
+		return nil
+	}
+	return appendPrefixes(nil, first, last)
+}
+
+// appendPrefixes appends the minimal list of prefixes of a range [first;last] of the same family.
+func appendPrefixes(prefixes []netip.Prefix, first, last netip.Addr) []netip.Prefix {
+	bits := first.BitLen()
+	lo, hi := toInt(first), toInt(last)
+	one := big.NewInt(1)
+	block := new(big.Int)
+	for lo.Cmp(hi) <= 0 {
+		// the largest block is limited by an alignment of lo, zero is aligned to any block
+		host := bits
+		if lo.Sign() != 0 {
+			host = int(lo.TrailingZeroBits())
+		}
+		for ; host > 0; host-- {
+			// lo + 2^host - 1 <= hi
+			block.Lsh(one, uint(host)).Add(block, lo).Sub(block, one)
+			if block.Cmp(hi) <= 0 {
+				break
+			}
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(fromInt(lo, bits), bits-host))
+		lo.Add(lo, block.Lsh(one, uint(host)))
+	}
+	return prefixes
+}
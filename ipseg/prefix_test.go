@@ -0,0 +1,64 @@
+package ipseg
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+)
+
+func TestFromPrefix(t *testing.T) {
+	tests := map[string]string{
+		"10.0.0.1/24":   "[10.0.0.0;10.0.0.255]",
+		"0.0.0.0/0":     "[0.0.0.0;255.255.255.255]",
+		"10.0.0.7/32":   "[10.0.0.7;10.0.0.7]",
+		"2001:db8::/32": "[2001:db8::;2001:db8:ffff:ffff:ffff:ffff:ffff:ffff]",
+		"::/0":          "[::;ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff]",
+	}
+	for input, want := range tests {
+		got, err := FromPrefix(netip.MustParsePrefix(input))
+		if err != nil || got.String() != want {
+			t.Errorf("FromPrefix(%s) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+	if _, err := FromPrefix(netip.Prefix{}); err == nil {
+		t.Errorf("FromPrefix() error = nil, want ErrInvalidAddr")
+	}
+}
+
+func TestIPSegment_Prefixes(t *testing.T) {
+	tests := map[string]string{
+		"[10.0.0.1;10.0.0.6]":          "[10.0.0.1/32 10.0.0.2/31 10.0.0.4/31 10.0.0.6/32]",
+		"[10.0.0.0;10.0.1.0)":          "[10.0.0.0/24]",
+		"(10.0.0.0;10.0.0.1)":          "[]",
+		"[0.0.0.0;+inf)":               "[0.0.0.0/0]",
+		"(0.0.0.0;+inf)":               "[0.0.0.1/32 0.0.0.2/31 0.0.0.4/30 0.0.0.8/29 0.0.0.16/28 0.0.0.32/27 0.0.0.64/26 0.0.0.128/25 0.0.1.0/24 0.0.2.0/23 0.0.4.0/22 0.0.8.0/21 0.0.16.0/20 0.0.32.0/19 0.0.64.0/18 0.0.128.0/17 0.1.0.0/16 0.2.0.0/15 0.4.0.0/14 0.8.0.0/13 0.16.0.0/12 0.32.0.0/11 0.64.0.0/10 0.128.0.0/9 1.0.0.0/8 2.0.0.0/7 4.0.0.0/6 8.0.0.0/5 16.0.0.0/4 32.0.0.0/3 64.0.0.0/2 128.0.0.0/1]",
+		"[::;+inf)":                    "[::/0]",
+		"[2001:db8::;2001:db8::2:0)":   "[2001:db8::/111]",
+		"[2001:db8::ff;2001:db8::100]": "[2001:db8::ff/128 2001:db8::100/128]",
+	}
+	for input, want := range tests {
+		if got := fmt.Sprint(MustParse(input).Prefixes()); got != want {
+			t.Errorf("Prefixes(%s) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestIPSegment_PrefixesRoundTrip(t *testing.T) {
+	for _, input := range []string{"[10.0.0.3;10.0.9.200]", "[2001:db8::7;2001:db8::1:3]", "[255.255.255.0;+inf)"} {
+		s := MustParse(input)
+		set := NewIPSet()
+		for _, p := range s.Prefixes() {
+			seg, err := FromPrefix(p)
+			if err != nil {
+				t.Fatalf("FromPrefix(%v) error = %v", p, err)
+			}
+			if set.ContainsSegment(seg) {
+				t.Errorf("Prefixes(%s) overlap at %v", input, p)
+			}
+			set.Add(seg)
+		}
+		if set.Len() != 1 || set.Segments()[0].String() != s.String() {
+			t.Errorf("Prefixes(%s) cover %v", input, set)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package ipseg
+
+import (
+	"errors"
+	"github.com/pioniro/segment-go"
+	"math/big"
+	"net/netip"
+)
+
+var (
+	ErrMixedFamilies = errors.New("borders of an IP segment must be of the same address family")
+	ErrNoFamily      = errors.New("an IP segment must have at least one bound border to know its address family")
+	ErrInvalidAddr   = errors.New("invalid IP address")
+)
+
+// IPSegment is an implementation of ISegment, TryToSegment, IncludedSegment interfaces for IP addresses.
+// All addresses of a segment are of the same family, unbound borders are replaced with the first or the last
+// address of the family: (-inf;10.0.0.255] -> [0.0.0.0;10.0.0.255]
+type IPSegment struct {
+	from segment.Border[netip.Addr]
+	till segment.Border[netip.Addr]
+}
+
+// NewIPSegment creates a new segment. It returns ErrMixedFamilies if borders are IPv4 and IPv6 addresses,
+// and ErrNoFamily if both borders are unbound.
+func NewIPSegment(from, till segment.Border[netip.Addr]) (*IPSegment, error) {
+	var first, last netip.Addr
+	switch {
+	case from.IsUnbound() && till.IsUnbound():
+		return nil, ErrNoFamily
+	case from.IsUnbound():
+		first, last = familyBounds(till.Value().Value())
+	default:
+		first, last = familyBounds(from.Value().Value())
+	}
+	if !first.IsValid() {
+		return nil, ErrInvalidAddr
+	}
+	if from.IsUnbound() {
+		from = segment.NewIncluded(Addr(first))
+	}
+	if till.IsUnbound() {
+		till = segment.NewIncluded(Addr(last))
+	}
+	if !till.Value().Value().IsValid() {
+		return nil, ErrInvalidAddr
+	}
+	if from.Value().Value().BitLen() != till.Value().Value().BitLen() {
+		return nil, ErrMixedFamilies
+	}
+	return &IPSegment{from: from, till: till}, nil
+}
+
+// MustNewIPSegment is like NewIPSegment, but panics in case of an error.
+func MustNewIPSegment(from, till segment.Border[netip.Addr]) *IPSegment {
+	s, err := NewIPSegment(from, till)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Range returns a segment [first;last] of addresses, see NewIPSegment for errors.
+func Range(first, last netip.Addr) (*IPSegment, error) {
+	return NewIPSegment(segment.NewIncluded(Addr(first)), segment.NewIncluded(Addr(last)))
+}
+
+func (s *IPSegment) From() *segment.Border[netip.Addr] {
+	return &s.from
+}
+
+func (s *IPSegment) Till() *segment.Border[netip.Addr] {
+	return &s.till
+}
+
+// TryTo tries to create a new segment from a given segment, but with different borders if it is possible.
+// If from value is Excluded(255.255.255.255) and we want to cast it to Included, then we return an error ErrHasNoNextValue.
+// If till value is Included(0.0.0.0) and we want to cast it to Excluded, then we return an error ErrHasNoPrevValue.
+func (s *IPSegment) TryTo(from segment.Bound, till segment.Bound) (segment.TryToSegment[netip.Addr], error) {
+	f, err := segment.LeftBoundTo(s.from, from)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := segment.RightBoundTo(s.till, till)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IPSegment{from: f, till: t}, nil
+}
+
+// String returns a string representation of a segment: [10.0.0.0;10.0.0.255], [2001:db8::;2001:db8::ff)
+func (s *IPSegment) String() string {
+	return segment.Format[netip.Addr](s)
+}
+
+// Is4 returns true if a segment is of IPv4 addresses.
+func (s *IPSegment) Is4() bool {
+	return s.from.Value().Value().Is4()
+}
+
+func (s *IPSegment) IsEmpty() bool {
+	_, _, ok := s.bounds()
+	return !ok
+}
+
+// IsIncludes returns true if a segment includes an address. Addresses of other family are never included.
+func (s *IPSegment) IsIncludes(point netip.Addr) bool {
+	first, last, ok := s.bounds()
+	point = point.WithZone("")
+	return ok && point.BitLen() == first.BitLen() && first.Compare(point) <= 0 && point.Compare(last) <= 0
+}
+
+// Size returns a number of addresses in a segment. It is a big.Int, because IPv6 segments can have up to 2^128 addresses.
+func (s *IPSegment) Size() (*big.Int, error) {
+	first, last, ok := s.bounds()
+	if !ok {
+		return new(big.Int), nil
+	}
+	size := new(big.Int).Sub(toInt(last), toInt(first))
+	return size.Add(size, big.NewInt(1)), nil
+}
+
+// bounds returns the first and the last addresses of a segment. If a segment is empty, then false will be returned.
+func (s *IPSegment) bounds() (netip.Addr, netip.Addr, bool) {
+	inc, err := s.TryTo(segment.Included, segment.Included)
+	// (255.255.255.255;... and ...;0.0.0.0) have no addresses
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, false
+	}
+	first, last := inc.From().Value().Value(), inc.Till().Value().Value()
+	return first, last, first.Compare(last) <= 0
+}
+
+// familyBounds returns the first and the last addresses of a family of an address.
+func familyBounds(a netip.Addr) (netip.Addr, netip.Addr) {
+	switch {
+	case a.Is4():
+		return netip.IPv4Unspecified(), netip.AddrFrom4([4]byte{255, 255, 255, 255})
+	case a.Is6():
+		var last [16]byte
+		for i := range last {
+			last[i] = 0xff
+		}
+		return netip.IPv6Unspecified(), netip.AddrFrom16(last)
+	}
+	return netip.Addr{}, netip.Addr{}
+}
+
+func toInt(a netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(a.AsSlice())
+}
+
+// fromInt converts a number to an address of a family with a given number of bits.
+func fromInt(n *big.Int, bits int) netip.Addr {
+	if bits == 32 {
+		var b [4]byte
+		n.FillBytes(b[:])
+		return netip.AddrFrom4(b)
+	}
+	var b [16]byte
+	n.FillBytes(b[:])
+	return netip.AddrFrom16(b)
+}
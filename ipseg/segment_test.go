@@ -0,0 +1,107 @@
+package ipseg
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/pioniro/segment-go"
+	"math/big"
+	"net/netip"
+	"testing"
+)
+
+func TestNewIPSegment(t *testing.T) {
+	v4 := segment.NewIncluded(Addr(netip.MustParseAddr("10.0.0.1")))
+	v6 := segment.NewIncluded(Addr(netip.MustParseAddr("2001:db8::1")))
+	if _, err := NewIPSegment(v4, v6); !errors.Is(err, ErrMixedFamilies) {
+		t.Errorf("NewIPSegment() error = %v, want ErrMixedFamilies", err)
+	}
+	if _, err := NewIPSegment(segment.NewUnbound[netip.Addr](), segment.NewUnbound[netip.Addr]()); !errors.Is(err, ErrNoFamily) {
+		t.Errorf("NewIPSegment() error = %v, want ErrNoFamily", err)
+	}
+	if _, err := NewIPSegment(segment.NewIncluded(Addr(netip.Addr{})), v4); !errors.Is(err, ErrInvalidAddr) {
+		t.Errorf("NewIPSegment() error = %v, want ErrInvalidAddr", err)
+	}
+	tests := map[string]string{
+		"[10.0.0.1;+inf)":   "[10.0.0.1;255.255.255.255]",
+		"(-inf;2001:db8::)": "[::;2001:db8::)",
+	}
+	for input, want := range tests {
+		if got := MustParse(input).String(); got != want {
+			t.Errorf("MustParse(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestIPSegment_IsEmpty(t *testing.T) {
+	tests := map[string]bool{
+		"[10.0.0.1;10.0.0.1]":                          false,
+		"[10.0.0.1;10.0.0.1)":                          true,
+		"(10.0.0.1;10.0.0.2)":                          true,
+		"(255.255.255.255;+inf)":                       true,
+		"(-inf;::)":                                    true,
+		"[::;ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff]": false,
+	}
+	for input, want := range tests {
+		if got := MustParse(input).IsEmpty(); got != want {
+			t.Errorf("IsEmpty(%s) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestIPSegment_IsIncludes(t *testing.T) {
+	s := MustParse("(10.0.0.0;10.0.1.0)")
+	tests := map[string]bool{
+		"10.0.0.0":        false,
+		"10.0.0.1":        true,
+		"10.0.0.255":      true,
+		"10.0.1.0":        false,
+		"::ffff:10.0.0.1": false,
+		"2001:db8::1":     false,
+	}
+	for point, want := range tests {
+		if got := s.IsIncludes(netip.MustParseAddr(point)); got != want {
+			t.Errorf("IsIncludes(%s) = %v, want %v", point, got, want)
+		}
+	}
+}
+
+func TestIPSegment_Size(t *testing.T) {
+	full6, _ := new(big.Int).SetString("340282366920938463463374607431768211456", 10)
+	tests := []struct {
+		input string
+		want  *big.Int
+	}{
+		{input: "[10.0.0.0;10.0.0.255]", want: big.NewInt(256)},
+		{input: "(10.0.0.0;10.0.0.1)", want: big.NewInt(0)},
+		{input: "[0.0.0.0;+inf)", want: big.NewInt(1 << 32)},
+		{input: "(-inf;ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff]", want: full6},
+	}
+	for _, tt := range tests {
+		got, err := MustParse(tt.input).Size()
+		if err != nil || got.Cmp(tt.want) != 0 {
+			t.Errorf("Size(%s) = %v, %v, want %v", tt.input, got, err, tt.want)
+		}
+	}
+}
+
+func TestIPSegment_JSON(t *testing.T) {
+	s := MustParse("[10.0.0.0;10.0.1.0)")
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"from":{"bound":"included","value":"10.0.0.0"},"till":{"bound":"excluded","value":"10.0.1.0"}}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	for _, input := range []string{want, `"[10.0.0.0;10.0.1.0)"`} {
+		var got IPSegment
+		if err := json.Unmarshal([]byte(input), &got); err != nil || got.String() != s.String() {
+			t.Errorf("Unmarshal(%s) = %v, %v", input, &got, err)
+		}
+	}
+	var got IPSegment
+	if err := json.Unmarshal([]byte(`"[10.0.0.0;2001:db8::]"`), &got); !errors.Is(err, ErrMixedFamilies) {
+		t.Errorf("Unmarshal() error = %v, want ErrMixedFamilies", err)
+	}
+}
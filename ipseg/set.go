@@ -0,0 +1,243 @@
+package ipseg
+
+import (
+	"github.com/pioniro/segment-go"
+	"iter"
+	"math/big"
+	"net/netip"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// addrRange is a non-empty range [first;last] of addresses of the same family.
+type addrRange struct {
+	first netip.Addr
+	last  netip.Addr
+}
+
+// IPSet is a normalized collection of IP segments, for example an allowlist.
+// Ranges of a set are always sorted, non-empty, non-overlapping and non-adjacent,
+// so [10.0.0.0;10.0.0.127] and [10.0.0.128;10.0.0.255] are stored as [10.0.0.0;10.0.0.255].
+// IPv4 and IPv6 addresses can be stored in the same set, IPv4 ranges go first.
+type IPSet struct {
+	ranges []addrRange
+}
+
+// NewIPSet creates a new set from given segments, merging overlapping and adjacent ones.
+func NewIPSet(segments ...*IPSegment) *IPSet {
+	ranges := make([]addrRange, 0, len(segments))
+	for _, seg := range segments {
+		if first, last, ok := seg.bounds(); ok {
+			ranges = append(ranges, addrRange{first: first, last: last})
+		}
+	}
+	slices.SortFunc(ranges, func(a, b addrRange) int {
+		return a.first.Compare(b.first)
+	})
+	return &IPSet{ranges: mergeSorted(ranges)}
+}
+
+// NewIPSetFromPrefixes creates a new set from given prefixes: 10.0.0.0/8, 192.168.0.0/16.
+func NewIPSetFromPrefixes(prefixes ...netip.Prefix) (*IPSet, error) {
+	segments := make([]*IPSegment, len(prefixes))
+	for i, p := range prefixes {
+		seg, err := FromPrefix(p)
+		if err != nil {
+			return nil, err
+		}
+		segments[i] = seg
+	}
+	return NewIPSet(segments...), nil
+}
+
+// Segments returns segments of a set sorted by their borders. All borders are Included.
+func (s *IPSet) Segments() []*IPSegment {
+	if len(s.ranges) == 0 {
+		return nil
+	}
+	return slices.Collect(s.All())
+}
+
+// All returns an iterator over segments of a set sorted by their borders.
+func (s *IPSet) All() iter.Seq[*IPSegment] {
+	return func(yield func(*IPSegment) bool) {
+		for _, r := range s.ranges {
+			if !yield(r.segment()) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns a number of segments in a set.
+func (s *IPSet) Len() int {
+	return len(s.ranges)
+}
+
+// IsEmpty returns true if a set does not include any address.
+func (s *IPSet) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// Size returns a number of addresses in a set.
+func (s *IPSet) Size() *big.Int {
+	size := new(big.Int)
+	for _, r := range s.ranges {
+		size.Add(size, toInt(r.last)).Sub(size, toInt(r.first)).Add(size, big.NewInt(1))
+	}
+	return size
+}
+
+// Add adds a segment to a set, merging it with overlapping and adjacent segments.
+func (s *IPSet) Add(seg *IPSegment) {
+	s.ranges = s.Union(NewIPSet(seg)).ranges
+}
+
+// Remove removes all addresses of a segment from a set, splitting segments if needed.
+func (s *IPSet) Remove(seg *IPSegment) {
+	s.ranges = s.Difference(NewIPSet(seg)).ranges
+}
+
+// Contains returns true if a set includes an address.
+func (s *IPSet) Contains(addr netip.Addr) bool {
+	addr = addr.WithZone("")
+	i := sort.Search(len(s.ranges), func(k int) bool {
+		return s.ranges[k].last.Compare(addr) >= 0
+	})
+	return i < len(s.ranges) && s.ranges[i].first.Compare(addr) <= 0
+}
+
+// ContainsSegment returns true if a set includes all addresses of a segment.
+// An empty segment is included in any set.
+func (s *IPSet) ContainsSegment(seg *IPSegment) bool {
+	first, last, ok := seg.bounds()
+	if !ok {
+		return true
+	}
+	i := sort.Search(len(s.ranges), func(k int) bool {
+		return s.ranges[k].last.Compare(first) >= 0
+	})
+	// ranges of a set are non-adjacent, so seg can be included in only one of them
+	return i < len(s.ranges) && s.ranges[i].first.Compare(first) <= 0 && last.Compare(s.ranges[i].last) <= 0
+}
+
+// Union returns a set of addresses, that are included in any of two sets. It takes O(n+m).
+func (s *IPSet) Union(o *IPSet) *IPSet {
+	result := make([]addrRange, 0, len(s.ranges)+len(o.ranges))
+	i, j := 0, 0
+	for i < len(s.ranges) || j < len(o.ranges) {
+		if j == len(o.ranges) || (i < len(s.ranges) && s.ranges[i].first.Compare(o.ranges[j].first) <= 0) {
+			result = append(result, s.ranges[i])
+			i++
+		} else {
+			result = append(result, o.ranges[j])
+			j++
+		}
+	}
+	return &IPSet{ranges: mergeSorted(result)}
+}
+
+// Intersect returns a set of addresses, that are included in both sets. It takes O(n+m).
+func (s *IPSet) Intersect(o *IPSet) *IPSet {
+	var result []addrRange
+	i, j := 0, 0
+	for i < len(s.ranges) && j < len(o.ranges) {
+		a, b := s.ranges[i], o.ranges[j]
+		first, last := maxAddr(a.first, b.first), minAddr(a.last, b.last)
+		if first.Compare(last) <= 0 {
+			result = append(result, addrRange{first: first, last: last})
+		}
+		// the range, that ends first, can not intersect anything else
+		if a.last.Compare(b.last) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return &IPSet{ranges: result}
+}
+
+// Difference returns a set of addresses, that are included in a set, but not in other set. It takes O(n+m).
+func (s *IPSet) Difference(o *IPSet) *IPSet {
+	var result []addrRange
+	j := 0
+	for _, r := range s.ranges {
+		// skip ranges of o, that end before r
+		for j < len(o.ranges) && o.ranges[j].last.Compare(r.first) < 0 {
+			j++
+		}
+		first := r.first
+		cut := false
+		for k := j; k < len(o.ranges) && o.ranges[k].first.Compare(r.last) <= 0; k++ {
+			if o.ranges[k].first.Compare(first) > 0 {
+				result = append(result, addrRange{first: first, last: o.ranges[k].first.Prev()})
+			}
+			// Next is invalid after the last address of a family, so nothing is left of r
+			first = o.ranges[k].last.Next()
+			if !first.IsValid() || first.Compare(r.last) > 0 {
+				cut = true
+				break
+			}
+		}
+		if !cut {
+			result = append(result, addrRange{first: first, last: r.last})
+		}
+	}
+	return &IPSet{ranges: result}
+}
+
+// Prefixes returns the minimal list of CIDR prefixes, that covers exactly the addresses of a set.
+func (s *IPSet) Prefixes() []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, r := range s.ranges {
+		prefixes = appendPrefixes(prefixes, r.first, r.last)
+	}
+	return prefixes
+}
+
+// String returns a string representation of a set.
+// example: {[10.0.0.0;10.0.0.255], [2001:db8::;2001:db8::ffff]}
+func (s *IPSet) String() string {
+	parts := make([]string, len(s.ranges))
+	for i, r := range s.ranges {
+		parts[i] = r.segment().String()
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+func (r addrRange) segment() *IPSegment {
+	return &IPSegment{from: segment.NewIncluded(Addr(r.first)), till: segment.NewIncluded(Addr(r.last))}
+}
+
+// mergeSorted merges overlapping and adjacent ranges, that are sorted by their first addresses.
+func mergeSorted(ranges []addrRange) []addrRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	result := ranges[:1]
+	for _, r := range ranges[1:] {
+		cur := &result[len(result)-1]
+		// ranges are adjacent if r starts right after cur, addresses of different families are never adjacent
+		if next := cur.last.Next(); r.first.Compare(cur.last) <= 0 || (next.IsValid() && r.first == next) {
+			cur.last = maxAddr(cur.last, r.last)
+			continue
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+func minAddr(a, b netip.Addr) netip.Addr {
+	if a.Compare(b) <= 0 {
+		return a
+	}
+	return b
+}
+
+func maxAddr(a, b netip.Addr) netip.Addr {
+	if a.Compare(b) >= 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,113 @@
+package ipseg
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+)
+
+func set(segments ...string) *IPSet {
+	result := NewIPSet()
+	for _, s := range segments {
+		result.Add(MustParse(s))
+	}
+	return result
+}
+
+func TestNewIPSet(t *testing.T) {
+	s := NewIPSet(
+		MustParse("[10.0.0.128;10.0.0.255]"),
+		MustParse("[2001:db8::;2001:db8::ff]"),
+		MustParse("[10.0.0.0;10.0.0.127]"),
+		MustParse("(10.0.1.0;10.0.1.1)"),
+		MustParse("[255.255.255.255;+inf)"),
+		MustParse("(-inf;::]"),
+	)
+	want := "{[10.0.0.0;10.0.0.255], [255.255.255.255;255.255.255.255], [::;::], [2001:db8::;2001:db8::ff]}"
+	if s.String() != want {
+		t.Errorf("NewIPSet() = %v, want %v", s, want)
+	}
+	if s.Size().Int64() != 256+1+1+256 {
+		t.Errorf("Size() = %v", s.Size())
+	}
+}
+
+func TestNewIPSetFromPrefixes(t *testing.T) {
+	s, err := NewIPSetFromPrefixes(netip.MustParsePrefix("10.0.0.0/25"), netip.MustParsePrefix("10.0.0.128/25"), netip.MustParsePrefix("10.0.1.0/24"))
+	if err != nil {
+		t.Fatalf("NewIPSetFromPrefixes() error = %v", err)
+	}
+	if got := fmt.Sprint(s.Prefixes()); got != "[10.0.0.0/23]" {
+		t.Errorf("Prefixes() = %v", got)
+	}
+	if _, err := NewIPSetFromPrefixes(netip.Prefix{}); err == nil {
+		t.Errorf("NewIPSetFromPrefixes() error = nil")
+	}
+}
+
+func TestIPSet_Contains(t *testing.T) {
+	s := set("[10.0.0.0;10.0.0.255]", "[10.0.2.0;10.0.2.255]", "[2001:db8::;2001:db8::ff]")
+	tests := map[string]bool{
+		"9.255.255.255":   false,
+		"10.0.0.0":        true,
+		"10.0.1.0":        false,
+		"10.0.2.255":      true,
+		"2001:db8::1":     true,
+		"2001:db8::100":   false,
+		"::ffff:10.0.0.1": false,
+	}
+	for point, want := range tests {
+		if got := s.Contains(netip.MustParseAddr(point)); got != want {
+			t.Errorf("Contains(%s) = %v, want %v", point, got, want)
+		}
+	}
+	segments := map[string]bool{
+		"[10.0.0.10;10.0.0.20]": true,
+		"[10.0.0.10;10.0.2.20]": false,
+		"(10.0.0.0;10.0.0.1)":   true,
+	}
+	for seg, want := range segments {
+		if got := s.ContainsSegment(MustParse(seg)); got != want {
+			t.Errorf("ContainsSegment(%s) = %v, want %v", seg, got, want)
+		}
+	}
+}
+
+func TestIPSet_Remove(t *testing.T) {
+	s := set("[10.0.0.0;10.0.0.255]", "[::;+inf)")
+	s.Remove(MustParse("[10.0.0.10;10.0.0.19]"))
+	s.Remove(MustParse("(-inf;::1]"))
+	s.Remove(MustParse("[ffff::;+inf)"))
+	want := "{[10.0.0.0;10.0.0.9], [10.0.0.20;10.0.0.255], [::2;fffe:ffff:ffff:ffff:ffff:ffff:ffff:ffff]}"
+	if s.String() != want {
+		t.Errorf("Remove() = %v, want %v", s, want)
+	}
+	s.Remove(MustParse("[0.0.0.0;+inf)"))
+	s.Remove(MustParse("[::;+inf)"))
+	if !s.IsEmpty() || s.Segments() != nil {
+		t.Errorf("Remove() = %v, want empty", s)
+	}
+}
+
+func TestIPSet_Operations(t *testing.T) {
+	a := set("[10.0.0.0;10.0.0.255]", "[10.0.2.0;10.0.3.255]", "[2001:db8::;2001:db8::ffff]")
+	b := set("[10.0.0.128;10.0.2.127]", "[192.168.0.0;192.168.0.255]", "[2001:db8::8000;2001:db8::1:0]")
+	tests := []struct {
+		name string
+		got  *IPSet
+		want string
+	}{
+		{name: "union", got: a.Union(b), want: "{[10.0.0.0;10.0.3.255], [192.168.0.0;192.168.0.255], [2001:db8::;2001:db8::1:0]}"},
+		{name: "intersect", got: a.Intersect(b), want: "{[10.0.0.128;10.0.0.255], [10.0.2.0;10.0.2.127], [2001:db8::8000;2001:db8::ffff]}"},
+		{name: "difference", got: a.Difference(b), want: "{[10.0.0.0;10.0.0.127], [10.0.2.128;10.0.3.255], [2001:db8::;2001:db8::7fff]}"},
+		{name: "reverse difference", got: b.Difference(a), want: "{[10.0.1.0;10.0.1.255], [192.168.0.0;192.168.0.255], [2001:db8::1:0;2001:db8::1:0]}"},
+		{name: "empty", got: a.Intersect(NewIPSet()), want: "{}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
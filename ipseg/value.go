@@ -0,0 +1,53 @@
+// Package ipseg provides segments of IP addresses with netip.Addr values, CIDR conversion and sets of segments.
+package ipseg
+
+import (
+	"github.com/pioniro/segment-go"
+	"net/netip"
+)
+
+type addrValue struct {
+	value netip.Addr
+}
+
+// Addr returns a value of an IPv4 or IPv6 address. A zone of an address is dropped.
+func Addr(a netip.Addr) segment.Value[netip.Addr] {
+	return &addrValue{
+		value: a.WithZone(""),
+	}
+}
+
+func (v *addrValue) Value() netip.Addr {
+	return v.value
+}
+
+func (v *addrValue) String() string {
+	return v.value.String()
+}
+
+// Next returns a next address or ErrHasNoNextValue for the last address of a family: 255.255.255.255 or ffff:...:ffff.
+func (v *addrValue) Next() (segment.Value[netip.Addr], error) {
+	next := v.value.Next()
+	if !next.IsValid() {
+		return v, segment.ErrHasNoNextValue
+	}
+	return &addrValue{value: next}, nil
+}
+
+// Prev returns a previous address or ErrHasNoPrevValue for the first address of a family: 0.0.0.0 or ::.
+func (v *addrValue) Prev() (segment.Value[netip.Addr], error) {
+	prev := v.value.Prev()
+	if !prev.IsValid() {
+		return v, segment.ErrHasNoPrevValue
+	}
+	return &addrValue{value: prev}, nil
+}
+
+// ParseAddr parses an IPv4 or IPv6 address: 10.0.0.1, 2001:db8::1.
+func ParseAddr(s string) (segment.Value[netip.Addr], error) {
+	a, err := netip.ParseAddr(s)
+	if err != nil {
+		return nil, err
+	}
+	return Addr(a), nil
+}
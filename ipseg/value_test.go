@@ -0,0 +1,44 @@
+package ipseg
+
+import (
+	"errors"
+	"github.com/pioniro/segment-go"
+	"net/netip"
+	"testing"
+)
+
+func TestAddr_NextPrev(t *testing.T) {
+	tests := []struct {
+		addr, next, prev string
+	}{
+		{addr: "10.0.0.255", next: "10.0.1.0", prev: "10.0.0.254"},
+		{addr: "2001:db8::ffff", next: "2001:db8::1:0", prev: "2001:db8::fffe"},
+		{addr: "fe80::1%eth0", next: "fe80::2", prev: "fe80::"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			v := Addr(netip.MustParseAddr(tt.addr))
+			next, err := v.Next()
+			if err != nil || next.String() != tt.next {
+				t.Errorf("Next() = %v, %v, want %v", next, err, tt.next)
+			}
+			prev, err := v.Prev()
+			if err != nil || prev.String() != tt.prev {
+				t.Errorf("Prev() = %v, %v, want %v", prev, err, tt.prev)
+			}
+		})
+	}
+}
+
+func TestAddr_Edges(t *testing.T) {
+	for _, s := range []string{"255.255.255.255", "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"} {
+		if _, err := Addr(netip.MustParseAddr(s)).Next(); !errors.Is(err, segment.ErrHasNoNextValue) {
+			t.Errorf("Next(%s) error = %v, want ErrHasNoNextValue", s, err)
+		}
+	}
+	for _, s := range []string{"0.0.0.0", "::"} {
+		if _, err := Addr(netip.MustParseAddr(s)).Prev(); !errors.Is(err, segment.ErrHasNoPrevValue) {
+			t.Errorf("Prev(%s) error = %v, want ErrHasNoPrevValue", s, err)
+		}
+	}
+}
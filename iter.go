@@ -0,0 +1,50 @@
+package segment
+
+import (
+	"github.com/pioniro/generator-go"
+	"iter"
+)
+
+// Seq converts a generator to an iterator, that can be used with range-over-func.
+// Errors of a generator are skipped, use Seq2 to get them.
+func Seq[T any](g generator.Generator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		g(func(v T, err error) bool {
+			if err != nil {
+				return true
+			}
+			return yield(v)
+		})
+	}
+}
+
+// Seq2 converts a generator to an iterator of values and errors: for v, err := range segment.Seq2(g) {...}
+func Seq2[T any](g generator.Generator[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		g(func(v T, err error) bool {
+			return yield(v, err)
+		})
+	}
+}
+
+// FromSeq converts an iterator to a generator.
+func FromSeq[T any](seq iter.Seq[T]) generator.Generator[T] {
+	return func(yield generator.Yield[T]) {
+		for v := range seq {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq2 converts an iterator of values and errors to a generator.
+func FromSeq2[T any](seq iter.Seq2[T, error]) generator.Generator[T] {
+	return func(yield generator.Yield[T]) {
+		for v, err := range seq {
+			if !yield(v, err) {
+				return
+			}
+		}
+	}
+}
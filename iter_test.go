@@ -0,0 +1,74 @@
+package segment
+
+import (
+	"errors"
+	"github.com/pioniro/generator-go"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+var errTest = errors.New("test error")
+
+func testGenerator(yield generator.Yield[int]) {
+	for i := 1; i <= 4; i++ {
+		var err error
+		if i == 3 {
+			err = errTest
+		}
+		if !yield(i, err) {
+			return
+		}
+	}
+}
+
+func TestSeq(t *testing.T) {
+	if got := slices.Collect(Seq[int](testGenerator)); !reflect.DeepEqual(got, []int{1, 2, 4}) {
+		t.Errorf("Seq() = %v, want [1 2 4]", got)
+	}
+	var got []int
+	for v := range Seq[int](testGenerator) {
+		got = append(got, v)
+		break
+	}
+	if !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("Seq() with break = %v, want [1]", got)
+	}
+}
+
+func TestSeq2(t *testing.T) {
+	var errs []error
+	var values []int
+	for v, err := range Seq2[int](testGenerator) {
+		values = append(values, v)
+		errs = append(errs, err)
+	}
+	if !reflect.DeepEqual(values, []int{1, 2, 3, 4}) || !reflect.DeepEqual(errs, []error{nil, nil, errTest, nil}) {
+		t.Errorf("Seq2() = %v %v", values, errs)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	if got := FromSeq(slices.Values([]int{1, 2, 3})).Collect(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("FromSeq() = %v, want [1 2 3]", got)
+	}
+	var got []int
+	FromSeq(slices.Values([]int{1, 2, 3}))(func(v int, err error) bool {
+		got = append(got, v)
+		return v < 2
+	})
+	if !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("FromSeq() with break = %v, want [1 2]", got)
+	}
+}
+
+func TestFromSeq2(t *testing.T) {
+	var errs []error
+	FromSeq2(Seq2[int](testGenerator))(func(v int, err error) bool {
+		errs = append(errs, err)
+		return true
+	})
+	if !reflect.DeepEqual(errs, []error{nil, nil, errTest, nil}) {
+		t.Errorf("FromSeq2() errors = %v", errs)
+	}
+}
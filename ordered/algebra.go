@@ -0,0 +1,172 @@
+package ordered
+
+import (
+	"cmp"
+	"github.com/pioniro/segment-go"
+	"sort"
+)
+
+// compareFrom compares two left borders.
+// Unbound is less than any other border, and for equal values Included starts before Excluded: [1 < (1
+func compareFrom[T ordered](a, b segment.Border[T]) int {
+	return segment.CompareFrom(a, b, cmp.Compare[T])
+}
+
+// compareTill compares two right borders.
+// Unbound is bigger than any other border, and for equal values Excluded ends before Included: 1) < 1]
+func compareTill[T ordered](a, b segment.Border[T]) int {
+	return segment.CompareTill(a, b, cmp.Compare[T])
+}
+
+// joins returns true if there is no gap between a right border till and a left border from,
+// i.e. a segment ending at till and a segment starting at from overlap or are adjacent.
+// For discrete values adjacency is detected with Next/Prev: 3] and [4 are joined, as well as 3) and [3.
+func joins[T ordered](till, from segment.Border[T]) bool {
+	if till.IsUnbound() || from.IsUnbound() {
+		return true
+	}
+	// the first value after till and the first value of from, [1;3] [4;6] -> 4 and 4, [1;3) [3;6] -> 3 and 3
+	after, errTill := RightBoundTo(till, segment.Excluded)
+	first, errFrom := LeftBoundTo(from, segment.Included)
+	if errTill == nil && errFrom == nil {
+		return first.Value().Value() <= after.Value().Value()
+	}
+	// Next/Prev is not possible here (limits of a type), so we compare values as is
+	c := cmp.Compare(till.Value().Value(), from.Value().Value())
+	return c > 0 || (c == 0 && (till.IsIncluded() || from.IsIncluded()))
+}
+
+// overlaps returns true if a segment ending at till and a segment starting at from have a common value.
+// For discrete values Next/Prev is used: 3) and (2 have no common value.
+func overlaps[T ordered](till, from segment.Border[T]) bool {
+	if till.IsUnbound() || from.IsUnbound() {
+		return true
+	}
+	last, errTill := RightBoundTo(till, segment.Included)
+	first, errFrom := LeftBoundTo(from, segment.Included)
+	if errTill == nil && errFrom == nil {
+		return first.Value().Value() <= last.Value().Value()
+	}
+	// Next/Prev is not possible here (limits of a type), so we compare values as is
+	c := cmp.Compare(till.Value().Value(), from.Value().Value())
+	return c > 0 || (c == 0 && till.IsIncluded() && from.IsIncluded())
+}
+
+// flip returns a border, that is opposite to a given one: [1 -> 1), (1 -> 1], 1] -> (1, 1) -> [1
+// Unbound border remains unbound.
+func flip[T ordered](b segment.Border[T]) segment.Border[T] {
+	switch {
+	case b.IsIncluded():
+		return segment.NewExcluded(b.Value())
+	case b.IsExcluded():
+		return segment.NewIncluded(b.Value())
+	}
+	return b
+}
+
+// Intersect returns an intersection of two segments.
+// If segments do not intersect, then false will be returned.
+//
+//	[1;5) ∩ (3;8] = (3;5)
+//	[1;3) ∩ [3;5] = ∅
+func (s *OrderedSegment[T]) Intersect(o *OrderedSegment[T]) (*OrderedSegment[T], bool) {
+	if s.IsEmpty() || o.IsEmpty() {
+		return nil, false
+	}
+	from := s.from
+	if compareFrom(o.from, from) > 0 {
+		from = o.from
+	}
+	till := s.till
+	if compareTill(o.till, till) < 0 {
+		till = o.till
+	}
+	res := NewOrderedSegment(from, till)
+	if res.IsEmpty() {
+		return nil, false
+	}
+	return res, true
+}
+
+// Union returns a union of two segments.
+// If segments overlap or are adjacent, then one segment will be returned, otherwise two segments ordered by their borders.
+// Empty segments are omitted, so a union of two empty segments is empty.
+//
+//	[1;3) ∪ [3;5] = [1;5]
+//	[1;3) ∪ (3;5] = [1;3), (3;5]
+func (s *OrderedSegment[T]) Union(o *OrderedSegment[T]) []*OrderedSegment[T] {
+	return normalize([]*OrderedSegment[T]{s, o})
+}
+
+// Difference returns a part of a segment, that is not included in other segment.
+// The result can consist of zero, one or two segments ordered by their borders.
+//
+//	[1;5] \ [2;3) = [1;2), [3;5]
+//	[1;5] \ (-inf;3] = (3;5]
+func (s *OrderedSegment[T]) Difference(o *OrderedSegment[T]) []*OrderedSegment[T] {
+	if s.IsEmpty() {
+		return nil
+	}
+	if _, ok := s.Intersect(o); !ok {
+		return []*OrderedSegment[T]{s}
+	}
+	var result []*OrderedSegment[T]
+	if !o.from.IsUnbound() {
+		if left := NewOrderedSegment(s.from, flip(o.from)); !left.IsEmpty() {
+			result = append(result, left)
+		}
+	}
+	if !o.till.IsUnbound() {
+		if right := NewOrderedSegment(flip(o.till), s.till); !right.IsEmpty() {
+			result = append(result, right)
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns values, that are included in exactly one of two segments.
+// The result can consist of zero, one or two segments ordered by their borders.
+//
+//	[1;5] △ [3;8) = [1;3), (5;8)
+//	[1;3) △ [3;5] = [1;5]
+func (s *OrderedSegment[T]) SymmetricDifference(o *OrderedSegment[T]) []*OrderedSegment[T] {
+	return normalize(append(s.Difference(o), o.Difference(s)...))
+}
+
+// normalize sorts segments, drops empty ones and merges overlapping and adjacent ones.
+func normalize[T ordered](segments []*OrderedSegment[T]) []*OrderedSegment[T] {
+	var result []*OrderedSegment[T]
+	for _, seg := range segments {
+		if !seg.IsEmpty() {
+			result = append(result, seg)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return compareFrom(result[i].from, result[j].from) < 0
+	})
+	return mergeSorted(result)
+}
+
+// mergeSorted merges overlapping and adjacent segments of a non-empty segments list sorted by left borders.
+// A given list is reused for the result.
+func mergeSorted[T ordered](segments []*OrderedSegment[T]) []*OrderedSegment[T] {
+	merged := segments[:0]
+	for _, seg := range segments {
+		if len(merged) == 0 {
+			merged = append(merged, seg)
+			continue
+		}
+		last := merged[len(merged)-1]
+		if !joins(last.till, seg.from) {
+			merged = append(merged, seg)
+			continue
+		}
+		if compareTill(seg.till, last.till) > 0 {
+			merged[len(merged)-1] = NewOrderedSegment(last.from, seg.till)
+		}
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
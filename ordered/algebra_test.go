@@ -0,0 +1,317 @@
+package ordered
+
+import (
+	"github.com/pioniro/segment-go"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func inc(v int64) segment.Border[int64] {
+	return segment.NewIncluded(NewTestValue(v))
+}
+
+func exc(v int64) segment.Border[int64] {
+	return segment.NewExcluded(NewTestValue(v))
+}
+
+func unb() segment.Border[int64] {
+	return segment.NewUnbound[int64]()
+}
+
+func seg(from, till segment.Border[int64]) *OrderedSegment[int64] {
+	return NewOrderedSegment(from, till)
+}
+
+func TestOrderedSegment_Intersect(t *testing.T) {
+	type testCase[T ordered] struct {
+		name   string
+		s      *OrderedSegment[T]
+		o      *OrderedSegment[T]
+		want   *OrderedSegment[T]
+		wantOk bool
+	}
+	tests := []testCase[int64]{
+		{
+			name:   "[1;5) ∩ (3;8]",
+			s:      seg(inc(1), exc(5)),
+			o:      seg(exc(3), inc(8)),
+			want:   seg(exc(3), exc(5)),
+			wantOk: true,
+		},
+		{
+			name:   "[1;3) ∩ [3;5]",
+			s:      seg(inc(1), exc(3)),
+			o:      seg(inc(3), inc(5)),
+			wantOk: false,
+		},
+		{
+			name:   "[1;3] ∩ [3;5]",
+			s:      seg(inc(1), inc(3)),
+			o:      seg(inc(3), inc(5)),
+			want:   seg(inc(3), inc(3)),
+			wantOk: true,
+		},
+		{
+			name:   "[1;3] ∩ (3;5]",
+			s:      seg(inc(1), inc(3)),
+			o:      seg(exc(3), inc(5)),
+			wantOk: false,
+		},
+		{
+			name:   "(1;3) ∩ (1;3]",
+			s:      seg(exc(1), exc(3)),
+			o:      seg(exc(1), inc(3)),
+			want:   seg(exc(1), exc(3)),
+			wantOk: true,
+		},
+		{
+			name:   "(1;3) ∩ [1;3]",
+			s:      seg(exc(1), exc(3)),
+			o:      seg(inc(1), inc(3)),
+			want:   seg(exc(1), exc(3)),
+			wantOk: true,
+		},
+		{
+			name:   "(inf;5] ∩ [1;inf)",
+			s:      seg(unb(), inc(5)),
+			o:      seg(inc(1), unb()),
+			want:   seg(inc(1), inc(5)),
+			wantOk: true,
+		},
+		{
+			name:   "(inf;inf) ∩ (inf;inf)",
+			s:      seg(unb(), unb()),
+			o:      seg(unb(), unb()),
+			want:   seg(unb(), unb()),
+			wantOk: true,
+		},
+		{
+			name:   "(inf;5] ∩ (5;inf)",
+			s:      seg(unb(), inc(5)),
+			o:      seg(exc(5), unb()),
+			wantOk: false,
+		},
+		{
+			name:   "(max;inf) ∩ (inf;inf)",
+			s:      seg(exc(math.MaxInt64), unb()),
+			o:      seg(unb(), unb()),
+			wantOk: false,
+		},
+		{
+			name:   "(inf;min) ∩ (inf;inf)",
+			s:      seg(unb(), exc(math.MinInt64)),
+			o:      seg(unb(), unb()),
+			wantOk: false,
+		},
+		{
+			name:   "[2;1] ∩ (inf;inf)",
+			s:      seg(inc(2), inc(1)),
+			o:      seg(unb(), unb()),
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.s.Intersect(tt.o)
+			if ok != tt.wantOk {
+				t.Fatalf("Intersect() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intersect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedSegment_Union(t *testing.T) {
+	type testCase[T ordered] struct {
+		name string
+		s    *OrderedSegment[T]
+		o    *OrderedSegment[T]
+		want []*OrderedSegment[T]
+	}
+	tests := []testCase[int64]{
+		{
+			name: "[1;3) ∪ [3;5]",
+			s:    seg(inc(1), exc(3)),
+			o:    seg(inc(3), inc(5)),
+			want: []*OrderedSegment[int64]{seg(inc(1), inc(5))},
+		},
+		{
+			name: "[1;3) ∪ (3;5]",
+			s:    seg(inc(1), exc(3)),
+			o:    seg(exc(3), inc(5)),
+			want: []*OrderedSegment[int64]{seg(inc(1), exc(3)), seg(exc(3), inc(5))},
+		},
+		{
+			name: "[1;3] ∪ [4;6]",
+			s:    seg(inc(1), inc(3)),
+			o:    seg(inc(4), inc(6)),
+			want: []*OrderedSegment[int64]{seg(inc(1), inc(6))},
+		},
+		{
+			name: "[4;6] ∪ [1;2]",
+			s:    seg(inc(4), inc(6)),
+			o:    seg(inc(1), inc(2)),
+			want: []*OrderedSegment[int64]{seg(inc(1), inc(2)), seg(inc(4), inc(6))},
+		},
+		{
+			name: "[1;10] ∪ [3;5]",
+			s:    seg(inc(1), inc(10)),
+			o:    seg(inc(3), inc(5)),
+			want: []*OrderedSegment[int64]{seg(inc(1), inc(10))},
+		},
+		{
+			name: "(inf;3] ∪ [2;inf)",
+			s:    seg(unb(), inc(3)),
+			o:    seg(inc(2), unb()),
+			want: []*OrderedSegment[int64]{seg(unb(), unb())},
+		},
+		{
+			name: "[2;1] ∪ [5;6]",
+			s:    seg(inc(2), inc(1)),
+			o:    seg(inc(5), inc(6)),
+			want: []*OrderedSegment[int64]{seg(inc(5), inc(6))},
+		},
+		{
+			name: "[2;1] ∪ (1;2)",
+			s:    seg(inc(2), inc(1)),
+			o:    seg(exc(1), exc(2)),
+			want: nil,
+		},
+		{
+			name: "[1;max] ∪ [max;max]",
+			s:    seg(inc(1), inc(math.MaxInt64)),
+			o:    seg(inc(math.MaxInt64), inc(math.MaxInt64)),
+			want: []*OrderedSegment[int64]{seg(inc(1), inc(math.MaxInt64))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Union(tt.o); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Union() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedSegment_Difference(t *testing.T) {
+	type testCase[T ordered] struct {
+		name string
+		s    *OrderedSegment[T]
+		o    *OrderedSegment[T]
+		want []*OrderedSegment[T]
+	}
+	tests := []testCase[int64]{
+		{
+			name: "[1;5] \\ [2;3)",
+			s:    seg(inc(1), inc(5)),
+			o:    seg(inc(2), exc(3)),
+			want: []*OrderedSegment[int64]{seg(inc(1), exc(2)), seg(inc(3), inc(5))},
+		},
+		{
+			name: "[1;5] \\ (inf;3]",
+			s:    seg(inc(1), inc(5)),
+			o:    seg(unb(), inc(3)),
+			want: []*OrderedSegment[int64]{seg(exc(3), inc(5))},
+		},
+		{
+			name: "[1;5] \\ [5;inf)",
+			s:    seg(inc(1), inc(5)),
+			o:    seg(inc(5), unb()),
+			want: []*OrderedSegment[int64]{seg(inc(1), exc(5))},
+		},
+		{
+			name: "[1;5] \\ [1;5]",
+			s:    seg(inc(1), inc(5)),
+			o:    seg(inc(1), inc(5)),
+			want: nil,
+		},
+		{
+			name: "[1;5] \\ (1;5)",
+			s:    seg(inc(1), inc(5)),
+			o:    seg(exc(1), exc(5)),
+			want: []*OrderedSegment[int64]{seg(inc(1), inc(1)), seg(inc(5), inc(5))},
+		},
+		{
+			name: "[1;5] \\ [6;8]",
+			s:    seg(inc(1), inc(5)),
+			o:    seg(inc(6), inc(8)),
+			want: []*OrderedSegment[int64]{seg(inc(1), inc(5))},
+		},
+		{
+			name: "(inf;inf) \\ [0;0]",
+			s:    seg(unb(), unb()),
+			o:    seg(inc(0), inc(0)),
+			want: []*OrderedSegment[int64]{seg(unb(), exc(0)), seg(exc(0), unb())},
+		},
+		{
+			name: "(inf;inf) \\ (inf;inf)",
+			s:    seg(unb(), unb()),
+			o:    seg(unb(), unb()),
+			want: nil,
+		},
+		{
+			name: "[1;max] \\ [1;max)",
+			s:    seg(inc(1), inc(math.MaxInt64)),
+			o:    seg(inc(1), exc(math.MaxInt64)),
+			want: []*OrderedSegment[int64]{seg(inc(math.MaxInt64), inc(math.MaxInt64))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Difference(tt.o); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Difference() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedSegment_SymmetricDifference(t *testing.T) {
+	type testCase[T ordered] struct {
+		name string
+		s    *OrderedSegment[T]
+		o    *OrderedSegment[T]
+		want []*OrderedSegment[T]
+	}
+	tests := []testCase[int64]{
+		{
+			name: "[1;5] △ [3;8)",
+			s:    seg(inc(1), inc(5)),
+			o:    seg(inc(3), exc(8)),
+			want: []*OrderedSegment[int64]{seg(inc(1), exc(3)), seg(exc(5), exc(8))},
+		},
+		{
+			name: "[1;3) △ [3;5]",
+			s:    seg(inc(1), exc(3)),
+			o:    seg(inc(3), inc(5)),
+			want: []*OrderedSegment[int64]{seg(inc(1), inc(5))},
+		},
+		{
+			name: "[1;3] △ [5;6]",
+			s:    seg(inc(1), inc(3)),
+			o:    seg(inc(5), inc(6)),
+			want: []*OrderedSegment[int64]{seg(inc(1), inc(3)), seg(inc(5), inc(6))},
+		},
+		{
+			name: "[1;3] △ [1;3]",
+			s:    seg(inc(1), inc(3)),
+			o:    seg(inc(1), inc(3)),
+			want: nil,
+		},
+		{
+			name: "(inf;3] △ [1;inf)",
+			s:    seg(unb(), inc(3)),
+			o:    seg(inc(1), unb()),
+			want: []*OrderedSegment[int64]{seg(unb(), exc(1)), seg(exc(3), unb())},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.SymmetricDifference(tt.o); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SymmetricDifference() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
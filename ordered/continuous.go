@@ -0,0 +1,38 @@
+package ordered
+
+import (
+	"fmt"
+	"github.com/pioniro/segment-go"
+)
+
+type continuousValue[T ordered] struct {
+	value T
+}
+
+// Continuous returns a value without next and prev values, see segment.Continuous.
+// Segments of such values are evaluated by comparison only: ["apple";"banana") includes "apricot".
+func Continuous[T ordered](v T) segment.Value[T] {
+	return &continuousValue[T]{
+		value: v,
+	}
+}
+
+func (v *continuousValue[T]) Continuous() {}
+
+func (v *continuousValue[T]) Value() T {
+	return v.value
+}
+
+func (v *continuousValue[T]) String() string {
+	return fmt.Sprint(v.value)
+}
+
+// Next returns ErrNotDiscrete, because a continuous value has no next value.
+func (v *continuousValue[T]) Next() (segment.Value[T], error) {
+	return v, segment.ErrNotDiscrete
+}
+
+// Prev returns ErrNotDiscrete, because a continuous value has no prev value.
+func (v *continuousValue[T]) Prev() (segment.Value[T], error) {
+	return v, segment.ErrNotDiscrete
+}
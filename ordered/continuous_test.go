@@ -0,0 +1,131 @@
+package ordered
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/pioniro/segment-go"
+	"reflect"
+	"testing"
+)
+
+func str(from, till string, fromInc, tillInc bool) *OrderedSegment[string] {
+	border := func(v string, inc bool) segment.Border[string] {
+		switch {
+		case v == "":
+			return segment.NewUnbound[string]()
+		case inc:
+			return segment.NewIncluded(Continuous(v))
+		}
+		return segment.NewExcluded(Continuous(v))
+	}
+	return NewOrderedSegment(border(from, fromInc), border(till, tillInc))
+}
+
+func TestContinuous_TryTo(t *testing.T) {
+	s := str("apple", "banana", true, false)
+	if _, err := s.TryTo(segment.Included, segment.Included); !errors.Is(err, segment.ErrNotDiscrete) {
+		t.Errorf("TryTo() error = %v, want ErrNotDiscrete", err)
+	}
+	got, err := s.TryTo(segment.Included, segment.Excluded)
+	if err != nil || got.(*OrderedSegment[string]).String() != "[apple;banana)" {
+		t.Errorf("TryTo() with the same bounds = %v, %v", got, err)
+	}
+	if _, err := Continuous("a").Next(); !errors.Is(err, segment.ErrNotDiscrete) {
+		t.Errorf("Next() error = %v, want ErrNotDiscrete", err)
+	}
+}
+
+func TestContinuous_IsEmpty(t *testing.T) {
+	tests := []struct {
+		s    *OrderedSegment[string]
+		want bool
+	}{
+		{s: str("a", "a", true, true), want: false},
+		{s: str("a", "a", true, false), want: true},
+		{s: str("a", "a", false, false), want: true},
+		{s: str("a", "a\x00", false, false), want: false},
+		{s: str("b", "a", true, true), want: true},
+		{s: str("", "a", false, false), want: false},
+		{s: str("a", "", false, false), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s.String(), func(t *testing.T) {
+			if got := tt.s.IsEmpty(); got != tt.want {
+				t.Errorf("IsEmpty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContinuous_IsIncludes(t *testing.T) {
+	s := str("apple", "banana", false, true)
+	tests := map[string]bool{
+		"apple":   false,
+		"apricot": true,
+		"banana":  true,
+		"bananas": false,
+		"":        false,
+	}
+	for point, want := range tests {
+		if got := s.IsIncludes(point); got != want {
+			t.Errorf("IsIncludes(%q) = %v, want %v", point, got, want)
+		}
+	}
+	if !str("", "b", false, false).IsIncludes("a") || str("", "b", false, false).IsIncludes("b") {
+		t.Errorf("IsIncludes() of (-inf;b) is wrong")
+	}
+}
+
+func TestContinuous_Algebra(t *testing.T) {
+	ab := str("a", "b", true, false)
+	bc := str("b", "c", true, true)
+	if got := ab.Union(bc); len(got) != 1 || got[0].String() != "[a;c]" {
+		t.Errorf("Union() = %v, want [[a;c]]", got)
+	}
+	if _, ok := ab.Intersect(bc); ok {
+		t.Errorf("Intersect() of [a;b) and [b;c] is not empty")
+	}
+	if got := str("a", "b", false, false).Union(str("b", "c", false, false)); len(got) != 2 {
+		t.Errorf("Union() of (a;b) and (b;c) = %v, want 2 segments", got)
+	}
+	var got []string
+	for _, s := range str("a", "z", true, true).Difference(str("c", "d", true, false)) {
+		got = append(got, s.String())
+	}
+	if want := []string{"[a;c)", "[d;z]"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Difference() = %v, want %v", got, want)
+	}
+	if r := Relate[string](ab, bc); r != Meets {
+		t.Errorf("Relate() = %v, want meets", r)
+	}
+	if r := Relate[string](str("a", "b", true, true), bc); r != Overlaps {
+		t.Errorf("Relate() = %v, want overlaps", r)
+	}
+}
+
+func TestContinuous_SegmentSet(t *testing.T) {
+	set := NewSegmentSet(str("a", "c", true, false), str("c", "e", true, true), str("x", "", false, false))
+	if got := set.String(); got != "{[a;e], (x;+inf)}" {
+		t.Errorf("NewSegmentSet() = %s, want {[a;e], (x;+inf)}", got)
+	}
+	if got := set.Complement().String(); got != "{(-inf;a), (e;x]}" {
+		t.Errorf("Complement() = %s, want {(-inf;a), (e;x]}", got)
+	}
+	if !set.Contains("d") || set.Contains("x") {
+		t.Errorf("Contains() is wrong for %s", set)
+	}
+}
+
+func TestContinuous_JSON(t *testing.T) {
+	segment.RegisterDefaultValue(Continuous[string], nil)
+	var s OrderedSegment[string]
+	if err := json.Unmarshal([]byte(`"[apple;banana)"`), &s); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !s.IsIncludes("apricot") || s.IsIncludes("banana") {
+		t.Errorf("Unmarshal() = %s, want [apple;banana)", s.String())
+	}
+	if _, err := s.TryTo(segment.Excluded, segment.Excluded); !errors.Is(err, segment.ErrNotDiscrete) {
+		t.Errorf("Unmarshal() created a discrete value, TryTo() error = %v", err)
+	}
+}
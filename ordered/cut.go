@@ -0,0 +1,79 @@
+package ordered
+
+import (
+	"cmp"
+	"github.com/pioniro/segment-go"
+	"slices"
+)
+
+// Cut defines, which piece of a segment a cut point belongs to, see SplitAt.
+type Cut int
+
+const (
+	// CutBefore makes a cut point the first value of the right piece: [1;10] at 5 -> [1;5), [5;10]
+	CutBefore Cut = iota
+	// CutAfter makes a cut point the last value of the left piece: [1;10] at 5 -> [1;5], (5;10]
+	CutAfter
+)
+
+// CutPoint is a point to cut a segment at and a side of a cut, see SplitAtCuts.
+type CutPoint[T ordered] struct {
+	Value T
+	Side  Cut
+}
+
+// SplitAt cuts a segment before given points, so each point is the first value of a piece:
+//
+//	[1;10] at 3, 7 = [1;3), [3;7), [7;10]
+//
+// OrderedSegment does not know, how to make a value of a border from T, so value creates it, e.g. Continuous[T]
+// or a discrete value of the same type as borders of a segment. See SplitAtCuts for details.
+func (s *OrderedSegment[T]) SplitAt(value func(T) segment.Value[T], points ...T) []*OrderedSegment[T] {
+	cuts := make([]CutPoint[T], len(points))
+	for i, p := range points {
+		cuts[i] = CutPoint[T]{Value: p, Side: CutBefore}
+	}
+	return s.SplitAtCuts(value, cuts...)
+}
+
+// SplitAtCuts cuts a segment at given points into pieces ordered by their borders, a side of each cut defines,
+// which piece its point belongs to:
+//
+//	[1;10] at 3 before, 7 before = [1;3), [3;7), [7;10]
+//	[1;10] at 3 after, 7 after   = [1;3], (3;7], (7;10]
+//	[1;10] at 5 before, 5 after  = [1;5), [5;5], (5;10]
+//
+// Cuts can be in any order, points out of a segment and duplicates are ignored, and empty pieces are not returned:
+// [1;10] at 1 before = [1;10]. A segment without cuts is returned as a single piece.
+func (s *OrderedSegment[T]) SplitAtCuts(value func(T) segment.Value[T], cuts ...CutPoint[T]) []*OrderedSegment[T] {
+	if s.IsEmpty() {
+		return nil
+	}
+	sorted := slices.Clone(cuts)
+	slices.SortFunc(sorted, func(a, b CutPoint[T]) int {
+		if c := cmp.Compare(a.Value, b.Value); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Side, b.Side)
+	})
+	var result []*OrderedSegment[T]
+	from := s.from
+	for i, c := range sorted {
+		if !s.IsIncludes(c.Value) || (i > 0 && sorted[i-1] == c) {
+			continue
+		}
+		v := value(c.Value)
+		till, next := segment.NewExcluded(v), segment.NewIncluded(v)
+		if c.Side == CutAfter {
+			till, next = segment.NewIncluded(v), segment.NewExcluded(v)
+		}
+		if piece := NewOrderedSegment(from, till); !piece.IsEmpty() {
+			result = append(result, piece)
+		}
+		from = next
+	}
+	if piece := NewOrderedSegment(from, s.till); !piece.IsEmpty() {
+		result = append(result, piece)
+	}
+	return result
+}
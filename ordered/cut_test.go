@@ -0,0 +1,65 @@
+package ordered
+
+import (
+	"fmt"
+	"github.com/pioniro/segment-go"
+	"testing"
+)
+
+func TestOrderedSegment_SplitAt(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      *OrderedSegment[int64]
+		points []int64
+		want   string
+	}{
+		{name: "before", s: seg(inc(1), inc(10)), points: []int64{7, 3}, want: "[[1;3) [3;7) [7;10]]"},
+		{name: "borders", s: seg(inc(1), inc(10)), points: []int64{1, 10, 10}, want: "[[1;10) [10;10]]"},
+		{name: "outside", s: seg(exc(1), exc(10)), points: []int64{-5, 1, 10, 20}, want: "[(1;10)]"},
+		{name: "adjacent", s: seg(inc(1), inc(3)), points: []int64{2, 3}, want: "[[1;2) [2;3) [3;3]]"},
+		{name: "no points", s: seg(inc(1), exc(5)), want: "[[1;5)]"},
+		{name: "empty", s: seg(inc(5), exc(5)), points: []int64{5}, want: "[]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprint(tt.s.SplitAt(NewTestValue, tt.points...)); got != tt.want {
+				t.Errorf("SplitAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedSegment_SplitAtCuts(t *testing.T) {
+	before := func(v int64) CutPoint[int64] { return CutPoint[int64]{Value: v, Side: CutBefore} }
+	after := func(v int64) CutPoint[int64] { return CutPoint[int64]{Value: v, Side: CutAfter} }
+	tests := []struct {
+		name string
+		s    *OrderedSegment[int64]
+		cuts []CutPoint[int64]
+		want string
+	}{
+		{name: "after", s: seg(inc(1), inc(10)), cuts: []CutPoint[int64]{after(3), after(7)}, want: "[[1;3] (3;7] (7;10]]"},
+		{name: "mixed", s: seg(inc(1), inc(10)), cuts: []CutPoint[int64]{after(7), before(3)}, want: "[[1;3) [3;7] (7;10]]"},
+		{name: "isolate", s: seg(inc(1), inc(10)), cuts: []CutPoint[int64]{after(5), before(5), after(5)}, want: "[[1;5) [5;5] (5;10]]"},
+		{name: "borders after", s: seg(inc(1), inc(10)), cuts: []CutPoint[int64]{after(1), after(10)}, want: "[[1;1] (1;10]]"},
+		{name: "unbound", s: seg(unb(), unb()), cuts: []CutPoint[int64]{after(0)}, want: "[(-inf;0] (0;+inf)]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprint(tt.s.SplitAtCuts(NewTestValue, tt.cuts...)); got != tt.want {
+				t.Errorf("SplitAtCuts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedSegment_SplitAt_Continuous(t *testing.T) {
+	s := NewOrderedSegment(segment.NewIncluded(Continuous("a")), segment.NewExcluded(Continuous("z")))
+	if got := fmt.Sprint(s.SplitAt(Continuous[string], "m", "a")); got != "[[a;m) [m;z)]" {
+		t.Errorf("SplitAt() = %v", got)
+	}
+	got := fmt.Sprint(s.SplitAtCuts(Continuous[string], CutPoint[string]{Value: "m", Side: CutAfter}))
+	if got != "[[a;m] (m;z)]" {
+		t.Errorf("SplitAtCuts() = %v", got)
+	}
+}
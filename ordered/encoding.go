@@ -0,0 +1,62 @@
+package ordered
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pioniro/segment-go"
+)
+
+// Notation is an OrderedSegment, that is marshaled to JSON as a string in interval notation instead of an object.
+// It is a conversion of a pointer: json.Marshal((*ordered.Notation[int])(seg)) -> "[1;2)"
+type Notation[T ordered] OrderedSegment[T]
+
+// MarshalJSON encodes a segment as an object: {"from":{"bound":"included","value":1},"till":{"bound":"unbound"}}.
+func (s *OrderedSegment[T]) MarshalJSON() ([]byte, error) {
+	return segment.MarshalSegmentJSON[T](s)
+}
+
+// UnmarshalJSON decodes a segment from an object or from a string in interval notation.
+// Values are created with a factory registered with segment.RegisterValue, ordered does not register any type itself,
+// so strings should be registered by a caller: segment.RegisterValue(ordered.Continuous[string], nil).
+func (s *OrderedSegment[T]) UnmarshalJSON(data []byte) error {
+	from, till, err := segment.UnmarshalSegmentJSONRegistered[T](data)
+	if err != nil {
+		return err
+	}
+	*s = *NewOrderedSegment(from, till)
+	return nil
+}
+
+// MarshalText encodes a segment in interval notation, see String.
+func (s *OrderedSegment[T]) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a segment in interval notation, values are parsed with a parser registered with segment.RegisterValue.
+func (s *OrderedSegment[T]) UnmarshalText(text []byte) error {
+	_, parse, ok := segment.LookupValue[T]()
+	if !ok {
+		var zero T
+		return fmt.Errorf("%w: %T", segment.ErrNoValueFactory, zero)
+	}
+	seg, err := Parse(string(text), parse)
+	if err != nil {
+		return err
+	}
+	*s = *seg
+	return nil
+}
+
+func (n *Notation[T]) String() string {
+	return (*OrderedSegment[T])(n).String()
+}
+
+// MarshalJSON encodes a segment as a string in interval notation: "[1;2)".
+func (n *Notation[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.String())
+}
+
+// UnmarshalJSON decodes a segment from a string in interval notation or from an object.
+func (n *Notation[T]) UnmarshalJSON(data []byte) error {
+	return (*OrderedSegment[T])(n).UnmarshalJSON(data)
+}
@@ -0,0 +1,117 @@
+package ordered
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/pioniro/segment-go"
+	"testing"
+)
+
+// registerTestValue registers test values of int64 for tests of UnmarshalJSON and UnmarshalText, that use the registry.
+// It registers a default, so it can be called by every test, and it doesn't replace an explicit registration.
+func registerTestValue() {
+	segment.RegisterDefaultValue(NewTestValue, parseTestValue)
+}
+
+func TestOrderedSegment_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		seg  *OrderedSegment[int64]
+		want string
+	}{
+		{seg: seg(inc(1), unb()), want: `{"from":{"bound":"included","value":1},"till":{"bound":"unbound"}}`},
+		{seg: seg(exc(-2), exc(3)), want: `{"from":{"bound":"excluded","value":-2},"till":{"bound":"excluded","value":3}}`},
+		{seg: seg(unb(), unb()), want: `{"from":{"bound":"unbound"},"till":{"bound":"unbound"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.seg.String(), func(t *testing.T) {
+			data, err := json.Marshal(tt.seg)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", data, tt.want)
+			}
+			from, till, err := segment.UnmarshalSegmentJSON(data, NewTestValue, parseTestValue)
+			if err != nil {
+				t.Fatalf("UnmarshalSegmentJSON() error = %v", err)
+			}
+			if got := NewOrderedSegment(from, till); got.String() != tt.seg.String() {
+				t.Errorf("UnmarshalSegmentJSON() = %s, want %s", got.String(), tt.seg.String())
+			}
+		})
+	}
+}
+
+func TestOrderedSegment_UnmarshalJSON(t *testing.T) {
+	registerTestValue()
+	var s struct {
+		Object   *OrderedSegment[int64] `json:"object"`
+		Notation *OrderedSegment[int64] `json:"notation"`
+	}
+	input := `{"object":{"from":{"bound":"excluded","value":1},"till":{"bound":"included","value":5}},"notation":"[1;+inf)"}`
+	if err := json.Unmarshal([]byte(input), &s); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if s.Object.String() != "(1;5]" || s.Notation.String() != "[1;+inf)" {
+		t.Errorf("Unmarshal() = %s %s, want (1;5] [1;+inf)", s.Object, s.Notation)
+	}
+	var got OrderedSegment[int64]
+	for _, input := range []string{`"[1;2"`, `{"from":{"bound":"included"},"till":{"bound":"unbound"}}`, `1`} {
+		if err := json.Unmarshal([]byte(input), &got); err == nil {
+			t.Errorf("Unmarshal(%s) error = nil", input)
+		}
+	}
+	var unregistered OrderedSegment[float32]
+	if err := json.Unmarshal([]byte(`"[1;2]"`), &unregistered); !errors.Is(err, segment.ErrNoValueFactory) {
+		t.Errorf("Unmarshal() of unregistered type error = %v, want ErrNoValueFactory", err)
+	}
+	if err := unregistered.UnmarshalText([]byte("[1;2]")); !errors.Is(err, segment.ErrNoValueFactory) {
+		t.Errorf("UnmarshalText() of unregistered type error = %v, want ErrNoValueFactory", err)
+	}
+}
+
+func TestOrderedSegment_MarshalText(t *testing.T) {
+	registerTestValue()
+	m := map[string]*OrderedSegment[int64]{
+		"a": seg(inc(1), exc(2)),
+		"b": seg(unb(), inc(5)),
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"a":{"from":{"bound":"included","value":1},"till":{"bound":"excluded","value":2}},"b":{"from":{"bound":"unbound"},"till":{"bound":"included","value":5}}}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	text, err := seg(unb(), inc(5)).MarshalText()
+	if err != nil || string(text) != "(-inf;5]" {
+		t.Fatalf("MarshalText() = %s, %v, want (-inf;5]", text, err)
+	}
+	var got OrderedSegment[int64]
+	if err := got.UnmarshalText(text); err != nil || got.String() != "(-inf;5]" {
+		t.Errorf("UnmarshalText() = %s, %v, want (-inf;5]", got.String(), err)
+	}
+}
+
+func TestNotation_MarshalJSON(t *testing.T) {
+	registerTestValue()
+	s := struct {
+		Range *Notation[int64] `json:"range"`
+	}{Range: (*Notation[int64])(seg(inc(1), exc(2)))}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(data) != `{"range":"[1;2)"}` {
+		t.Errorf("Marshal() = %s, want {\"range\":\"[1;2)\"}", data)
+	}
+	s.Range = nil
+	if err := json.Unmarshal([]byte(`{"range":{"from":{"bound":"unbound"},"till":{"bound":"included","value":3}}}`), &s); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if s.Range.String() != "(-inf;3]" {
+		t.Errorf("Unmarshal() = %s, want (-inf;3]", s.Range)
+	}
+}
@@ -0,0 +1,22 @@
+package ordered
+
+import "github.com/pioniro/segment-go"
+
+// Parse parses a segment in interval notation, that is produced by String: [1;2), (-inf;5], (1, 2].
+// Values are parsed with a given parser, see segment.ParseBorders for details.
+func Parse[T ordered](s string, parse segment.ValueParser[T]) (*OrderedSegment[T], error) {
+	from, till, err := segment.ParseBorders(s, parse)
+	if err != nil {
+		return nil, err
+	}
+	return NewOrderedSegment(from, till), nil
+}
+
+// MustParse is like Parse, but panics if a string cannot be parsed.
+func MustParse[T ordered](s string, parse segment.ValueParser[T]) *OrderedSegment[T] {
+	seg, err := Parse(s, parse)
+	if err != nil {
+		panic(err)
+	}
+	return seg
+}
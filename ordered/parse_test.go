@@ -0,0 +1,64 @@
+package ordered
+
+import (
+	"github.com/pioniro/segment-go"
+	"strconv"
+	"testing"
+)
+
+func parseTestValue(s string) (segment.Value[int64], error) {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return NewTestValue(v), nil
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  *OrderedSegment[int64]
+	}{
+		{input: "[1;2)", want: seg(inc(1), exc(2))},
+		{input: "(1, 2]", want: seg(exc(1), inc(2))},
+		{input: "(-inf;5]", want: seg(unb(), inc(5))},
+		{input: "[ -3 ; ∞ )", want: seg(inc(-3), unb())},
+		{input: "(inf;inf)", want: seg(unb(), unb())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Parse(tt.input, parseTestValue)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got.String() != tt.want.String() {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	bounds := []segment.Border[int64]{inc(-7), exc(-7), inc(0), exc(12), unb()}
+	for _, from := range bounds {
+		for _, till := range bounds {
+			s := seg(from, till)
+			got, err := Parse(s.String(), parseTestValue)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", s.String(), err)
+			}
+			if compareFrom(got.from, s.from) != 0 || compareTill(got.till, s.till) != 0 || got.String() != s.String() {
+				t.Errorf("Parse(%q) = %v", s.String(), got)
+			}
+		}
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParse() did not panic")
+		}
+	}()
+	MustParse("[1;", parseTestValue)
+}
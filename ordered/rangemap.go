@@ -0,0 +1,151 @@
+package ordered
+
+import (
+	"fmt"
+	gen "github.com/pioniro/generator-go"
+	"github.com/pioniro/segment-go"
+	"iter"
+	"sort"
+	"strings"
+)
+
+// RangeEntry is a segment with a value, that is stored in a RangeMap.
+type RangeEntry[K ordered, V comparable] struct {
+	Segment *OrderedSegment[K]
+	Value   V
+}
+
+// RangeMap maps non-overlapping segments to values.
+// Assigning a value to a segment splits all entries it overlaps, so only the last assigned value is kept for every point:
+//
+//	{[1;10]: a} + [3;5): b = {[1;3): a, [3;5): b, [5;10]: a}
+//
+// Adjacent entries with equal values are merged: {[1;3): a} + [3;5]: a = {[1;5]: a}
+type RangeMap[K ordered, V comparable] struct {
+	entries []RangeEntry[K, V]
+}
+
+func NewRangeMap[K ordered, V comparable]() *RangeMap[K, V] {
+	return &RangeMap[K, V]{}
+}
+
+// Len returns a number of entries in a map.
+func (m *RangeMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Get returns a value of a point. If the point is not mapped, then false will be returned.
+func (m *RangeMap[K, V]) Get(point K) (V, bool) {
+	i := sort.Search(len(m.entries), func(k int) bool {
+		return tillReaches(m.entries[k].Segment.till, point)
+	})
+	if i < len(m.entries) && m.entries[i].Segment.IsIncludes(point) {
+		return m.entries[i].Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Set assigns a value to all points of a segment, splitting entries it overlaps.
+func (m *RangeMap[K, V]) Set(seg segment.ISegment[K], value V) {
+	s := NewOrderedSegment(*seg.From(), *seg.Till())
+	if s.IsEmpty() {
+		return
+	}
+	i, j, before, after := m.cut(s)
+	entries := make([]RangeEntry[K, V], 0, len(before)+1+len(after))
+	entries = append(entries, before...)
+	entries = append(entries, RangeEntry[K, V]{Segment: s, Value: value})
+	entries = append(entries, after...)
+	m.replace(i, j, entries)
+
+	// merge the new entry with its neighbours, if they have the same value
+	pos := i + len(before)
+	if next := pos + 1; next < len(m.entries) && m.entries[next].Value == value && joins(s.till, m.entries[next].Segment.from) {
+		m.entries[pos].Segment = NewOrderedSegment(m.entries[pos].Segment.from, m.entries[next].Segment.till)
+		m.replace(next, next+1, nil)
+	}
+	if prev := pos - 1; prev >= 0 && m.entries[prev].Value == value && joins(m.entries[prev].Segment.till, s.from) {
+		m.entries[prev].Segment = NewOrderedSegment(m.entries[prev].Segment.from, m.entries[pos].Segment.till)
+		m.replace(pos, pos+1, nil)
+	}
+}
+
+// Delete removes all points of a segment from a map, splitting entries it overlaps.
+func (m *RangeMap[K, V]) Delete(seg segment.ISegment[K]) {
+	s := NewOrderedSegment(*seg.From(), *seg.Till())
+	if s.IsEmpty() {
+		return
+	}
+	i, j, before, after := m.cut(s)
+	m.replace(i, j, append(before, after...))
+}
+
+// Entries returns a generator of all entries ordered by their segments.
+func (m *RangeMap[K, V]) Entries() gen.Generator[RangeEntry[K, V]] {
+	return func(yield gen.Yield[RangeEntry[K, V]]) {
+		for _, entry := range m.entries {
+			if !yield(entry, nil) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over segments and values of all entries ordered by their segments:
+// for seg, v := range m.All() {...}
+func (m *RangeMap[K, V]) All() iter.Seq2[*OrderedSegment[K], V] {
+	return func(yield func(*OrderedSegment[K], V) bool) {
+		for _, entry := range m.entries {
+			if !yield(entry.Segment, entry.Value) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of a map.
+// example: {[1;3): a, [3;5): b}
+func (m *RangeMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i, entry := range m.entries {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(entry.Segment.String())
+		sb.WriteString(": ")
+		sb.WriteString(fmt.Sprint(entry.Value))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// cut finds entries [i;j), that overlap a segment, and returns their parts before and after the segment.
+func (m *RangeMap[K, V]) cut(s *OrderedSegment[K]) (i, j int, before, after []RangeEntry[K, V]) {
+	i = sort.Search(len(m.entries), func(k int) bool {
+		return overlaps(m.entries[k].Segment.till, s.from)
+	})
+	j = i + sort.Search(len(m.entries)-i, func(k int) bool {
+		return !overlaps(s.till, m.entries[i+k].Segment.from)
+	})
+	for _, entry := range m.entries[i:j] {
+		for _, part := range entry.Segment.Difference(s) {
+			if compareFrom(part.from, s.from) < 0 {
+				before = append(before, RangeEntry[K, V]{Segment: part, Value: entry.Value})
+			} else {
+				after = append(after, RangeEntry[K, V]{Segment: part, Value: entry.Value})
+			}
+		}
+	}
+	return i, j, before, after
+}
+
+// replace replaces entries [i;j) with given ones.
+func (m *RangeMap[K, V]) replace(i, j int, entries []RangeEntry[K, V]) {
+	result := make([]RangeEntry[K, V], 0, len(m.entries)-(j-i)+len(entries))
+	result = append(result, m.entries[:i]...)
+	result = append(result, entries...)
+	result = append(result, m.entries[j:]...)
+	m.entries = result
+}
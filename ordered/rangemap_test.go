@@ -0,0 +1,215 @@
+package ordered
+
+import (
+	"github.com/pioniro/segment-go"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+type rangeMapOp struct {
+	seg    *OrderedSegment[int64]
+	value  string
+	delete bool
+}
+
+func TestRangeMap_Set(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []rangeMapOp
+		want string
+	}{
+		{
+			name: "split",
+			ops: []rangeMapOp{
+				{seg: seg(inc(1), inc(10)), value: "a"},
+				{seg: seg(inc(3), exc(5)), value: "b"},
+			},
+			want: "{[1;3): a, [3;5): b, [5;10]: a}",
+		},
+		{
+			name: "overwrite several",
+			ops: []rangeMapOp{
+				{seg: seg(inc(1), exc(3)), value: "a"},
+				{seg: seg(inc(3), exc(5)), value: "b"},
+				{seg: seg(inc(5), exc(7)), value: "c"},
+				{seg: seg(inc(2), inc(5)), value: "d"},
+			},
+			want: "{[1;2): a, [2;5]: d, (5;7): c}",
+		},
+		{
+			name: "merge adjacent equal values",
+			ops: []rangeMapOp{
+				{seg: seg(inc(1), exc(3)), value: "a"},
+				{seg: seg(inc(5), exc(7)), value: "a"},
+				{seg: seg(inc(3), exc(5)), value: "a"},
+			},
+			want: "{[1;7): a}",
+		},
+		{
+			name: "merge discrete neighbours",
+			ops: []rangeMapOp{
+				{seg: seg(inc(1), inc(3)), value: "a"},
+				{seg: seg(inc(4), inc(6)), value: "a"},
+			},
+			want: "{[1;6]: a}",
+		},
+		{
+			name: "do not merge different values",
+			ops: []rangeMapOp{
+				{seg: seg(inc(1), inc(3)), value: "a"},
+				{seg: seg(inc(4), inc(6)), value: "b"},
+			},
+			want: "{[1;3]: a, [4;6]: b}",
+		},
+		{
+			name: "same value inside",
+			ops: []rangeMapOp{
+				{seg: seg(inc(1), inc(10)), value: "a"},
+				{seg: seg(inc(3), inc(5)), value: "a"},
+			},
+			want: "{[1;10]: a}",
+		},
+		{
+			name: "unbound",
+			ops: []rangeMapOp{
+				{seg: seg(unb(), unb()), value: "a"},
+				{seg: seg(inc(0), exc(10)), value: "b"},
+			},
+			want: "{(-inf;0): a, [0;10): b, [10;+inf): a}",
+		},
+		{
+			name: "delete",
+			ops: []rangeMapOp{
+				{seg: seg(inc(1), inc(10)), value: "a"},
+				{seg: seg(inc(20), inc(30)), value: "b"},
+				{seg: seg(exc(5), inc(25)), delete: true},
+			},
+			want: "{[1;5]: a, (25;30]: b}",
+		},
+		{
+			name: "empty segment",
+			ops: []rangeMapOp{
+				{seg: seg(inc(1), inc(10)), value: "a"},
+				{seg: seg(exc(3), exc(4)), value: "b"},
+			},
+			want: "{[1;10]: a}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewRangeMap[int64, string]()
+			for _, op := range tt.ops {
+				if op.delete {
+					m.Delete(op.seg)
+				} else {
+					m.Set(op.seg, op.value)
+				}
+			}
+			if got := m.String(); got != tt.want {
+				t.Errorf("RangeMap = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeMap_Get(t *testing.T) {
+	m := NewRangeMap[int64, string]()
+	m.Set(seg(unb(), exc(0)), "negative")
+	m.Set(seg(inc(1), inc(10)), "a")
+	m.Set(seg(exc(3), exc(5)), "b")
+	cases := map[int64]string{
+		-1: "negative",
+		0:  "",
+		1:  "a",
+		3:  "a",
+		4:  "b",
+		5:  "a",
+		10: "a",
+		11: "",
+	}
+	for point, want := range cases {
+		got, ok := m.Get(point)
+		if got != want || ok != (want != "") {
+			t.Errorf("Get(%d) = %v, %v, want %v", point, got, ok, want)
+		}
+	}
+}
+
+func TestRangeMap_Entries(t *testing.T) {
+	m := NewRangeMap[int64, int]()
+	m.Set(seg(inc(5), inc(6)), 2)
+	m.Set(seg(inc(1), inc(2)), 1)
+	entries := m.Entries().Collect()
+	if len(entries) != 2 || entries[0].Value != 1 || entries[1].Value != 2 {
+		t.Errorf("Entries() = %v", entries)
+	}
+	if got, want := entries[0].Segment.String(), "[1;2]"; got != want {
+		t.Errorf("Entries()[0] = %v, want %v", got, want)
+	}
+}
+
+// TestRangeMap_Random compares a map with a brute force over a small domain.
+func TestRangeMap_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomBorder := func() segment.Border[int64] {
+		switch r.Intn(6) {
+		case 0:
+			return unb()
+		case 1, 2:
+			return exc(r.Int63n(30))
+		}
+		return inc(r.Int63n(30))
+	}
+	for n := 0; n < 200; n++ {
+		m := NewRangeMap[int64, int]()
+		want := map[int64]int{}
+		for i := 0; i < 10; i++ {
+			s := seg(randomBorder(), randomBorder())
+			value := r.Intn(3)
+			del := r.Intn(4) == 0
+			if del {
+				m.Delete(s)
+			} else {
+				m.Set(s, value)
+			}
+			for v := int64(-5); v < 35; v++ {
+				if !s.IsIncludes(v) {
+					continue
+				}
+				if del {
+					delete(want, v)
+				} else {
+					want[v] = value
+				}
+			}
+		}
+		for k := 1; k < len(m.entries); k++ {
+			prev, entry := m.entries[k-1], m.entries[k]
+			if overlaps(prev.Segment.till, entry.Segment.from) ||
+				(prev.Value == entry.Value && joins(prev.Segment.till, entry.Segment.from)) {
+				t.Fatalf("%v is not normalized", m)
+			}
+		}
+		for v := int64(-5); v < 35; v++ {
+			got, ok := m.Get(v)
+			value, wantOk := want[v]
+			if ok != wantOk || got != value {
+				t.Fatalf("%v.Get(%d) = %v, %v, want %v, %v", m, v, got, ok, value, wantOk)
+			}
+		}
+	}
+}
+
+func TestRangeMap_All(t *testing.T) {
+	m := NewRangeMap[int64, string]()
+	m.Set(seg(inc(1), exc(3)), "a")
+	m.Set(seg(inc(5), unb()), "b")
+	var got []string
+	for s, v := range m.All() {
+		got = append(got, s.String()+": "+v)
+	}
+	if want := []string{"[1;3): a", "[5;+inf): b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,170 @@
+package ordered
+
+import "github.com/pioniro/segment-go"
+
+// Relation is one of Allen's 13 relations between two segments.
+type Relation int
+
+const (
+	// Undefined is a relation of empty segments.
+	Undefined Relation = iota
+	// Before - a ends before b starts, and there is a gap between them: [1;2] [4;5]
+	Before
+	// Meets - a ends right where b starts: [1;3) [3;5]
+	Meets
+	// Overlaps - a starts before b and ends inside b: [1;3] [3;5]
+	Overlaps
+	// Starts - a starts together with b and ends before b: [1;3] [1;5]
+	Starts
+	// During - a starts after b and ends before b: [2;3] [1;5]
+	During
+	// Finishes - a starts after b and ends together with b: [3;5] [1;5]
+	Finishes
+	// Equals - a and b have the same values: [1;5] [1;5]
+	Equals
+	// After is an inverse of Before
+	After
+	// MetBy is an inverse of Meets
+	MetBy
+	// OverlappedBy is an inverse of Overlaps
+	OverlappedBy
+	// StartedBy is an inverse of Starts
+	StartedBy
+	// Contains is an inverse of During
+	Contains
+	// FinishedBy is an inverse of Finishes
+	FinishedBy
+)
+
+var relationNames = map[Relation]string{
+	Undefined:    "undefined",
+	Before:       "before",
+	Meets:        "meets",
+	Overlaps:     "overlaps",
+	Starts:       "starts",
+	During:       "during",
+	Finishes:     "finishes",
+	Equals:       "equals",
+	After:        "after",
+	MetBy:        "met by",
+	OverlappedBy: "overlapped by",
+	StartedBy:    "started by",
+	Contains:     "contains",
+	FinishedBy:   "finished by",
+}
+
+var relationInverses = map[Relation]Relation{
+	Undefined:    Undefined,
+	Before:       After,
+	Meets:        MetBy,
+	Overlaps:     OverlappedBy,
+	Starts:       StartedBy,
+	During:       Contains,
+	Finishes:     FinishedBy,
+	Equals:       Equals,
+	After:        Before,
+	MetBy:        Meets,
+	OverlappedBy: Overlaps,
+	StartedBy:    Starts,
+	Contains:     During,
+	FinishedBy:   Finishes,
+}
+
+func (r Relation) String() string {
+	if name, ok := relationNames[r]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Inverse returns a relation of b to a, if r is a relation of a to b: Before -> After, Equals -> Equals.
+func (r Relation) Inverse() Relation {
+	return relationInverses[r]
+}
+
+// Relate returns a relation of segment a to segment b.
+// Borders are compared with taking into account their bounds, and for discrete values Next/Prev is used,
+// so [1;3) meets [3;5], [1;3] meets [4;5] of integers, but [1;3] overlaps [3;5].
+// If any of segments is empty, then Undefined will be returned.
+func Relate[T ordered](a, b segment.ISegment[T]) Relation {
+	x, y := canonicalSegment(a), canonicalSegment(b)
+	if x.IsEmpty() || y.IsEmpty() {
+		return Undefined
+	}
+	if !overlaps(x.till, y.from) && compareFrom(x.from, y.from) < 0 {
+		if joins(x.till, y.from) {
+			return Meets
+		}
+		return Before
+	}
+	if !overlaps(y.till, x.from) && compareFrom(y.from, x.from) < 0 {
+		if joins(y.till, x.from) {
+			return MetBy
+		}
+		return After
+	}
+	from, till := compareFrom(x.from, y.from), compareTill(x.till, y.till)
+	switch {
+	case from == 0 && till == 0:
+		return Equals
+	case from == 0 && till < 0:
+		return Starts
+	case from == 0:
+		return StartedBy
+	case from > 0 && till == 0:
+		return Finishes
+	case till == 0:
+		return FinishedBy
+	case from > 0 && till < 0:
+		return During
+	case from < 0 && till > 0:
+		return Contains
+	case from < 0:
+		return Overlaps
+	}
+	return OverlappedBy
+}
+
+// Overlaps returns true if segments have at least one common value.
+// Unlike the Overlaps relation, it is true for any relation except Before, Meets, After, MetBy and Undefined.
+func (s *OrderedSegment[T]) Overlaps(o segment.ISegment[T]) bool {
+	switch Relate[T](s, o) {
+	case Undefined, Before, Meets, After, MetBy:
+		return false
+	}
+	return true
+}
+
+// Adjacent returns true if segments do not have common values, but there is no gap between them: [1;3) and [3;5]
+func (s *OrderedSegment[T]) Adjacent(o segment.ISegment[T]) bool {
+	r := Relate[T](s, o)
+	return r == Meets || r == MetBy
+}
+
+// Precedes returns true if all values of a segment are less than values of other segment: [1;3) and [3;5]
+func (s *OrderedSegment[T]) Precedes(o segment.ISegment[T]) bool {
+	r := Relate[T](s, o)
+	return r == Before || r == Meets
+}
+
+// ContainsSegment returns true if a segment includes all values of other segment.
+// An empty segment is included in any segment.
+func (s *OrderedSegment[T]) ContainsSegment(o segment.ISegment[T]) bool {
+	if NewOrderedSegment(*o.From(), *o.Till()).IsEmpty() {
+		return true
+	}
+	switch Relate[T](s, o) {
+	case Equals, StartedBy, Contains, FinishedBy:
+		return true
+	}
+	return false
+}
+
+// canonicalSegment casts a segment to a segment with included borders if it is possible: (1;5) -> [2;4]
+func canonicalSegment[T ordered](s segment.ISegment[T]) *OrderedSegment[T] {
+	seg := NewOrderedSegment(*s.From(), *s.Till())
+	if inc, err := seg.TryTo(segment.Included, segment.Included); err == nil {
+		return inc.(*OrderedSegment[T])
+	}
+	return seg
+}
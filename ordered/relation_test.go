@@ -0,0 +1,154 @@
+package ordered
+
+import (
+	"github.com/pioniro/segment-go"
+	"math/rand"
+	"testing"
+)
+
+func TestRelate(t *testing.T) {
+	type testCase[T ordered] struct {
+		name string
+		a    *OrderedSegment[T]
+		b    *OrderedSegment[T]
+		want Relation
+	}
+	tests := []testCase[int64]{
+		{name: "[1;2] [4;5]", a: seg(inc(1), inc(2)), b: seg(inc(4), inc(5)), want: Before},
+		{name: "[1;3) [3;5]", a: seg(inc(1), exc(3)), b: seg(inc(3), inc(5)), want: Meets},
+		{name: "[1;3] (3;5]", a: seg(inc(1), inc(3)), b: seg(exc(3), inc(5)), want: Meets},
+		{name: "[1;3] [4;5]", a: seg(inc(1), inc(3)), b: seg(inc(4), inc(5)), want: Meets},
+		{name: "[1;3) (3;5]", a: seg(inc(1), exc(3)), b: seg(exc(3), inc(5)), want: Before},
+		{name: "[1;3] [3;5]", a: seg(inc(1), inc(3)), b: seg(inc(3), inc(5)), want: Overlaps},
+		{name: "[1;3] [1;5]", a: seg(inc(1), inc(3)), b: seg(inc(1), inc(5)), want: Starts},
+		{name: "(0;3] [1;5]", a: seg(exc(0), inc(3)), b: seg(inc(1), inc(5)), want: Starts},
+		{name: "[2;3] [1;5]", a: seg(inc(2), inc(3)), b: seg(inc(1), inc(5)), want: During},
+		{name: "[3;5] [1;5]", a: seg(inc(3), inc(5)), b: seg(inc(1), inc(5)), want: Finishes},
+		{name: "[1;5] (0;6)", a: seg(inc(1), inc(5)), b: seg(exc(0), exc(6)), want: Equals},
+		{name: "[4;5] [1;2]", a: seg(inc(4), inc(5)), b: seg(inc(1), inc(2)), want: After},
+		{name: "[3;5] [1;3)", a: seg(inc(3), inc(5)), b: seg(inc(1), exc(3)), want: MetBy},
+		{name: "[3;5] [1;3]", a: seg(inc(3), inc(5)), b: seg(inc(1), inc(3)), want: OverlappedBy},
+		{name: "[1;5] [1;3]", a: seg(inc(1), inc(5)), b: seg(inc(1), inc(3)), want: StartedBy},
+		{name: "[1;5] [2;3]", a: seg(inc(1), inc(5)), b: seg(inc(2), inc(3)), want: Contains},
+		{name: "[1;5] [3;5]", a: seg(inc(1), inc(5)), b: seg(inc(3), inc(5)), want: FinishedBy},
+		{name: "(inf;3) [3;inf)", a: seg(unb(), exc(3)), b: seg(inc(3), unb()), want: Meets},
+		{name: "(inf;3) (inf;5]", a: seg(unb(), exc(3)), b: seg(unb(), inc(5)), want: Starts},
+		{name: "(inf;inf) [1;5]", a: seg(unb(), unb()), b: seg(inc(1), inc(5)), want: Contains},
+		{name: "(inf;inf) (inf;inf)", a: seg(unb(), unb()), b: seg(unb(), unb()), want: Equals},
+		{name: "[1;inf) (inf;3]", a: seg(inc(1), unb()), b: seg(unb(), inc(3)), want: OverlappedBy},
+		{name: "(1;2) [1;5]", a: seg(exc(1), exc(2)), b: seg(inc(1), inc(5)), want: Undefined},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Relate[int64](tt.a, tt.b); got != tt.want {
+				t.Errorf("Relate() = %v, want %v", got, tt.want)
+			}
+			if got := Relate[int64](tt.b, tt.a); got != tt.want.Inverse() {
+				t.Errorf("inverse Relate() = %v, want %v", got, tt.want.Inverse())
+			}
+		})
+	}
+}
+
+// TestRelate_Random compares relations with relations of minimal and maximal values of segments.
+func TestRelate_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomBorder := func() segment.Border[int64] {
+		if r.Intn(2) == 0 {
+			return exc(r.Int63n(10))
+		}
+		return inc(r.Int63n(10))
+	}
+	bounds := func(s *OrderedSegment[int64]) (int64, int64, bool) {
+		first, last, ok := int64(0), int64(0), false
+		for v := int64(-1); v <= 10; v++ {
+			if s.IsIncludes(v) {
+				if !ok {
+					first = v
+				}
+				last, ok = v, true
+			}
+		}
+		return first, last, ok
+	}
+	for i := 0; i < 2000; i++ {
+		a, b := seg(randomBorder(), randomBorder()), seg(randomBorder(), randomBorder())
+		a1, a2, aok := bounds(a)
+		b1, b2, bok := bounds(b)
+		var want Relation
+		switch {
+		case !aok || !bok:
+			want = Undefined
+		case a2+1 < b1:
+			want = Before
+		case a2+1 == b1:
+			want = Meets
+		case b2+1 < a1:
+			want = After
+		case b2+1 == a1:
+			want = MetBy
+		case a1 == b1 && a2 == b2:
+			want = Equals
+		case a1 == b1 && a2 < b2:
+			want = Starts
+		case a1 == b1:
+			want = StartedBy
+		case a2 == b2 && a1 > b1:
+			want = Finishes
+		case a2 == b2:
+			want = FinishedBy
+		case a1 > b1 && a2 < b2:
+			want = During
+		case a1 < b1 && a2 > b2:
+			want = Contains
+		case a1 < b1:
+			want = Overlaps
+		default:
+			want = OverlappedBy
+		}
+		if got := Relate[int64](a, b); got != want {
+			t.Fatalf("Relate(%v, %v) = %v, want %v", a, b, got, want)
+		}
+	}
+}
+
+func TestOrderedSegment_Predicates(t *testing.T) {
+	tests := []struct {
+		name                                   string
+		a, b                                   *OrderedSegment[int64]
+		overlaps, adjacent, precedes, contains bool
+	}{
+		{name: "[1;3) [3;5]", a: seg(inc(1), exc(3)), b: seg(inc(3), inc(5)), adjacent: true, precedes: true},
+		{name: "[1;3] [3;5]", a: seg(inc(1), inc(3)), b: seg(inc(3), inc(5)), overlaps: true},
+		{name: "[1;2] [4;5]", a: seg(inc(1), inc(2)), b: seg(inc(4), inc(5)), precedes: true},
+		{name: "[4;5] [1;3]", a: seg(inc(4), inc(5)), b: seg(inc(1), inc(3)), adjacent: true},
+		{name: "(inf;inf) [1;5]", a: seg(unb(), unb()), b: seg(inc(1), inc(5)), overlaps: true, contains: true},
+		{name: "[1;5] [1;5]", a: seg(inc(1), inc(5)), b: seg(inc(1), inc(5)), overlaps: true, contains: true},
+		{name: "[1;5] (1;2)", a: seg(inc(1), inc(5)), b: seg(exc(1), exc(2)), contains: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Overlaps(tt.b); got != tt.overlaps {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.overlaps)
+			}
+			if got := tt.a.Adjacent(tt.b); got != tt.adjacent {
+				t.Errorf("Adjacent() = %v, want %v", got, tt.adjacent)
+			}
+			if got := tt.a.Precedes(tt.b); got != tt.precedes {
+				t.Errorf("Precedes() = %v, want %v", got, tt.precedes)
+			}
+			if got := tt.a.ContainsSegment(tt.b); got != tt.contains {
+				t.Errorf("ContainsSegment() = %v, want %v", got, tt.contains)
+			}
+		})
+	}
+}
+
+func TestRelation_String(t *testing.T) {
+	if got := MetBy.String(); got != "met by" {
+		t.Errorf("String() = %v, want %v", got, "met by")
+	}
+	if got := Relation(100).String(); got != "unknown" {
+		t.Errorf("String() = %v, want %v", got, "unknown")
+	}
+}
@@ -1,7 +1,7 @@
 package ordered
 
 import (
-	"fmt"
+	"cmp"
 	"github.com/pioniro/segment-go"
 )
 
@@ -21,8 +21,8 @@ type OrderedSegment[T ordered] struct {
 
 func NewOrderedSegment[T ordered](from, till segment.Border[T]) *OrderedSegment[T] {
 	return &OrderedSegment[T]{
-		from: from,
-		till: till,
+		from: from.AsFrom(),
+		till: till.AsTill(),
 	}
 }
 func (s *OrderedSegment[T]) From() *segment.Border[T] {
@@ -48,36 +48,32 @@ func (s *OrderedSegment[T]) TryTo(from segment.Bound, till segment.Bound) (segme
 }
 
 // String returns a string representation of a segment.
-// example: [1;2], (1;2], [1;2), (1;2), [1;inf), (1;inf), (inf;2], (inf;2), (inf;inf)
+// example: [1;2], (1;2], [1;2), (1;2), [1;+inf), (1;+inf), (-inf;2], (-inf;2), (-inf;+inf)
 func (s *OrderedSegment[T]) String() string {
-	leftBound := "("
-	rightBound := ")"
-	from := s.from
-	till := s.till
-	if s.from.IsIncluded() {
-		leftBound = "["
-	}
-	if s.till.IsIncluded() {
-		rightBound = "]"
-	}
-	return fmt.Sprintf("%s%s;%s%s", leftBound, from.Value(), till.Value(), rightBound)
+	return segment.Format[T](s)
 }
 
 func (s *OrderedSegment[T]) IsEmpty() bool {
-	if s.From().IsUnbound() || s.Till().IsUnbound() {
+	if s.From().IsUnbound() && s.Till().IsUnbound() {
 		return false
 	}
+	if s.isContinuous() {
+		return segment.IsEmptyBorders(s.from, s.till, cmp.Compare[T])
+	}
 	inc, err := s.TryTo(segment.Included, segment.Included)
 	// this is possible only if rightsegment.Border is segment.Excluded minimum or leftsegment.Border is segment.Excluded maximum
 	if err != nil {
 		return true
 	}
+	if inc.From().IsUnbound() || inc.Till().IsUnbound() {
+		return false
+	}
 	return inc.From().Value().Value() > inc.Till().Value().Value()
 }
 
 func (s *OrderedSegment[T]) IsIncludes(point T) bool {
-	if s.From().IsUnbound() && s.Till().IsUnbound() {
-		return true
+	if s.isContinuous() {
+		return segment.IncludesFrom(s.from, point, cmp.Compare[T]) && segment.IncludesTill(s.till, point, cmp.Compare[T])
 	}
 	inc, err := s.TryTo(segment.Included, segment.Included)
 	// this is possible only if rightsegment.Border is segment.Excluded minimum or leftsegment.Border is segment.Excluded maximum.
@@ -85,53 +81,20 @@ func (s *OrderedSegment[T]) IsIncludes(point T) bool {
 	if err != nil {
 		return false
 	}
-	if s.From().IsUnbound() {
-		return inc.Till().Value().Value() >= point
-	}
-	if s.Till().IsUnbound() {
-		return inc.From().Value().Value() <= point
-	}
-	return inc.From().Value().Value() <= point && inc.Till().Value().Value() >= point
+	return segment.IncludesFrom(*inc.From(), point, cmp.Compare[T]) && segment.IncludesTill(*inc.Till(), point, cmp.Compare[T])
 }
 
+// isContinuous returns true if any border of a segment has a continuous value, see segment.Continuous.
+func (s *OrderedSegment[T]) isContinuous() bool {
+	return segment.IsContinuous(s.from.Value()) || segment.IsContinuous(s.till.Value())
+}
+
+// LeftBoundTo converts a left border to a given bound: (1 -> [2, [2 -> (1. See segment.LeftBoundTo.
 func LeftBoundTo[T ordered](b segment.Border[T], to segment.Bound) (segment.Border[T], error) {
-	if !b.IsUnbound() && !b.IsBound(to) {
-		switch to {
-		case segment.Included:
-			value, err := b.Value().Next()
-			if err != nil {
-				return b, err
-			}
-			b = segment.NewBorder(segment.Included, value)
-		case segment.Excluded:
-			value, err := b.Value().Prev()
-			if err != nil {
-				return b, err
-			}
-			b = segment.NewBorder(segment.Excluded, value)
-		case segment.Unbound:
-		}
-	}
-	return b, nil
+	return segment.LeftBoundTo(b, to)
 }
 
+// RightBoundTo converts a right border to a given bound: 1) -> 0], 0] -> 1). See segment.RightBoundTo.
 func RightBoundTo[T ordered](b segment.Border[T], to segment.Bound) (segment.Border[T], error) {
-	if !b.IsUnbound() && !b.IsBound(to) {
-		switch to {
-		case segment.Included:
-			value, err := b.Value().Prev()
-			if err != nil {
-				return b, err
-			}
-			b = segment.NewBorder(segment.Included, value)
-		case segment.Excluded:
-			value, err := b.Value().Next()
-			if err != nil {
-				return b, err
-			}
-			b = segment.NewBorder(segment.Excluded, value)
-		case segment.Unbound:
-		}
-	}
-	return b, nil
+	return segment.RightBoundTo(b, to)
 }
@@ -95,19 +95,19 @@ func TestOrderedSegment_String(t *testing.T) {
 			want: "[1;100)",
 		},
 		{
-			name: "[1;inf)",
+			name: "[1;+inf)",
 			s:    NewOrderedSegment(segment.NewBorder(segment.Included, NewTestValue(1)), segment.NewUnbound[int64]()),
-			want: "[1;inf)",
+			want: "[1;+inf)",
 		},
 		{
-			name: "(inf;100)",
+			name: "(-inf;100)",
 			s:    NewOrderedSegment(segment.NewUnbound[int64](), segment.NewBorder(segment.Excluded, NewTestValue(100))),
-			want: "(inf;100)",
+			want: "(-inf;100)",
 		},
 		{
-			name: "(inf;inf)",
+			name: "(-inf;+inf)",
 			s:    NewOrderedSegment(segment.NewUnbound[int64](), segment.NewUnbound[int64]()),
-			want: "(inf;inf)",
+			want: "(-inf;+inf)",
 		},
 	}
 	for _, tt := range tests {
@@ -255,9 +255,9 @@ func TestOrderedSegment_From(t *testing.T) {
 			want: segment.NewBorder(segment.Excluded, NewTestValue(1)),
 		},
 		{
-			name: "(inf;2]",
+			name: "(-inf;2]",
 			s:    NewOrderedSegment(segment.NewBorder(segment.Unbound, NewTestValue(1)), segment.NewBorder(segment.Included, NewTestValue(2))),
-			want: segment.NewBorder(segment.Unbound, segment.Inf[int64]()),
+			want: segment.NewLeftUnbound[int64](),
 		},
 	}
 	for _, tt := range tests {
@@ -287,9 +287,9 @@ func TestOrderedSegment_Till(t *testing.T) {
 			want: segment.NewBorder(segment.Excluded, NewTestValue(2)),
 		},
 		{
-			name: "[1;inf)",
+			name: "[1;+inf)",
 			s:    NewOrderedSegment(segment.NewBorder(segment.Included, NewTestValue(1)), segment.NewBorder(segment.Unbound, NewTestValue(2))),
-			want: segment.NewBorder(segment.Unbound, segment.Inf[int64]()),
+			want: segment.NewRightUnbound[int64](),
 		},
 	}
 	for _, tt := range tests {
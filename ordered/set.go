@@ -0,0 +1,203 @@
+package ordered
+
+import (
+	"github.com/pioniro/segment-go"
+	"iter"
+	"sort"
+	"strings"
+)
+
+// SegmentSet is a normalized collection of segments.
+// Segments of a set are always sorted, non-empty, non-overlapping and non-adjacent,
+// so [1;3] and [4;6] of a discrete type are stored as [1;6].
+type SegmentSet[T ordered] struct {
+	segments []*OrderedSegment[T]
+}
+
+// NewSegmentSet creates a new set from given segments, merging overlapping and adjacent ones.
+func NewSegmentSet[T ordered](segments ...*OrderedSegment[T]) *SegmentSet[T] {
+	return &SegmentSet[T]{
+		segments: normalize(segments),
+	}
+}
+
+// Segments returns segments of a set sorted by their borders.
+func (s *SegmentSet[T]) Segments() []*OrderedSegment[T] {
+	if len(s.segments) == 0 {
+		return nil
+	}
+	result := make([]*OrderedSegment[T], len(s.segments))
+	copy(result, s.segments)
+	return result
+}
+
+// All returns an iterator over segments of a set sorted by their borders.
+func (s *SegmentSet[T]) All() iter.Seq[*OrderedSegment[T]] {
+	return func(yield func(*OrderedSegment[T]) bool) {
+		for _, seg := range s.segments {
+			if !yield(seg) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns a number of segments in a set.
+func (s *SegmentSet[T]) Len() int {
+	return len(s.segments)
+}
+
+// IsEmpty returns true if a set does not include any value.
+func (s *SegmentSet[T]) IsEmpty() bool {
+	return len(s.segments) == 0
+}
+
+// Add adds a segment to a set, merging it with overlapping and adjacent segments.
+func (s *SegmentSet[T]) Add(seg *OrderedSegment[T]) {
+	if seg.IsEmpty() {
+		return
+	}
+	// segments [0;i) are before seg with a gap, segments [j;n) are after seg with a gap
+	i := sort.Search(len(s.segments), func(k int) bool {
+		return joins(s.segments[k].till, seg.from)
+	})
+	j := i + sort.Search(len(s.segments)-i, func(k int) bool {
+		return !joins(seg.till, s.segments[i+k].from)
+	})
+	merged := seg
+	if i < j {
+		from := seg.from
+		if compareFrom(s.segments[i].from, from) < 0 {
+			from = s.segments[i].from
+		}
+		till := seg.till
+		if compareTill(s.segments[j-1].till, till) > 0 {
+			till = s.segments[j-1].till
+		}
+		merged = NewOrderedSegment(from, till)
+	}
+	s.replace(i, j, merged)
+}
+
+// Remove removes all values of a segment from a set, splitting segments if needed.
+func (s *SegmentSet[T]) Remove(seg *OrderedSegment[T]) {
+	if seg.IsEmpty() {
+		return
+	}
+	// segments [0;i) are before seg, segments [j;n) are after seg, segments [i;j) overlap seg
+	i := sort.Search(len(s.segments), func(k int) bool {
+		return overlaps(s.segments[k].till, seg.from)
+	})
+	j := i + sort.Search(len(s.segments)-i, func(k int) bool {
+		return !overlaps(seg.till, s.segments[i+k].from)
+	})
+	var rest []*OrderedSegment[T]
+	for _, cur := range s.segments[i:j] {
+		rest = append(rest, cur.Difference(seg)...)
+	}
+	s.replace(i, j, rest...)
+}
+
+// replace replaces segments [i;j) with given ones.
+func (s *SegmentSet[T]) replace(i, j int, segments ...*OrderedSegment[T]) {
+	result := make([]*OrderedSegment[T], 0, len(s.segments)-(j-i)+len(segments))
+	result = append(result, s.segments[:i]...)
+	result = append(result, segments...)
+	result = append(result, s.segments[j:]...)
+	if len(result) == 0 {
+		result = nil
+	}
+	s.segments = result
+}
+
+// Contains returns true if a set includes a point.
+func (s *SegmentSet[T]) Contains(point T) bool {
+	i := sort.Search(len(s.segments), func(k int) bool {
+		return tillReaches(s.segments[k].till, point)
+	})
+	return i < len(s.segments) && s.segments[i].IsIncludes(point)
+}
+
+// ContainsSegment returns true if a set includes all values of a segment.
+// An empty segment is included in any set.
+func (s *SegmentSet[T]) ContainsSegment(seg *OrderedSegment[T]) bool {
+	if seg.IsEmpty() {
+		return true
+	}
+	i := sort.Search(len(s.segments), func(k int) bool {
+		return overlaps(s.segments[k].till, seg.from)
+	})
+	// segments of a set are non-adjacent, so seg can be included in only one of them
+	return i < len(s.segments) && len(seg.Difference(s.segments[i])) == 0
+}
+
+// Complement returns a set of all values, that are not included in a set.
+//
+//	{[1;3], (5;+inf)} -> {(-inf;1), (3;5]}
+func (s *SegmentSet[T]) Complement() *SegmentSet[T] {
+	var result []*OrderedSegment[T]
+	from := segment.NewUnbound[T]()
+	for _, seg := range s.segments {
+		if !seg.from.IsUnbound() {
+			if gap := NewOrderedSegment(from, flip(seg.from)); !gap.IsEmpty() {
+				result = append(result, gap)
+			}
+		}
+		from = flip(seg.till)
+	}
+	if len(s.segments) == 0 || !s.segments[len(s.segments)-1].till.IsUnbound() {
+		if gap := NewOrderedSegment(from, segment.NewUnbound[T]()); !gap.IsEmpty() {
+			result = append(result, gap)
+		}
+	}
+	return &SegmentSet[T]{segments: result}
+}
+
+// Union returns a set of values, that are included in any of two sets. It takes O(n+m).
+func (s *SegmentSet[T]) Union(o *SegmentSet[T]) *SegmentSet[T] {
+	result := make([]*OrderedSegment[T], 0, len(s.segments)+len(o.segments))
+	i, j := 0, 0
+	for i < len(s.segments) || j < len(o.segments) {
+		if j == len(o.segments) || (i < len(s.segments) && compareFrom(s.segments[i].from, o.segments[j].from) <= 0) {
+			result = append(result, s.segments[i])
+			i++
+		} else {
+			result = append(result, o.segments[j])
+			j++
+		}
+	}
+	return &SegmentSet[T]{segments: mergeSorted(result)}
+}
+
+// Intersect returns a set of values, that are included in both sets. It takes O(n+m).
+func (s *SegmentSet[T]) Intersect(o *SegmentSet[T]) *SegmentSet[T] {
+	var result []*OrderedSegment[T]
+	i, j := 0, 0
+	for i < len(s.segments) && j < len(o.segments) {
+		if res, ok := s.segments[i].Intersect(o.segments[j]); ok {
+			result = append(result, res)
+		}
+		// the segment, that ends first, can not intersect anything else
+		if compareTill(s.segments[i].till, o.segments[j].till) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return &SegmentSet[T]{segments: result}
+}
+
+// Difference returns a set of values, that are included in a set, but not in other set. It takes O(n+m).
+func (s *SegmentSet[T]) Difference(o *SegmentSet[T]) *SegmentSet[T] {
+	return s.Intersect(o.Complement())
+}
+
+// String returns a string representation of a set.
+// example: {[1;3], (5;+inf)}
+func (s *SegmentSet[T]) String() string {
+	parts := make([]string, len(s.segments))
+	for i, seg := range s.segments {
+		parts[i] = seg.String()
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
@@ -0,0 +1,337 @@
+package ordered
+
+import (
+	"github.com/pioniro/segment-go"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func set(segments ...*OrderedSegment[int64]) *SegmentSet[int64] {
+	return NewSegmentSet(segments...)
+}
+
+func TestNewSegmentSet(t *testing.T) {
+	type testCase[T ordered] struct {
+		name     string
+		segments []*OrderedSegment[T]
+		want     []*OrderedSegment[T]
+	}
+	tests := []testCase[int64]{
+		{
+			name:     "empty",
+			segments: nil,
+			want:     nil,
+		},
+		{
+			name:     "[1;3] [4;6]",
+			segments: []*OrderedSegment[int64]{seg(inc(1), inc(3)), seg(inc(4), inc(6))},
+			want:     []*OrderedSegment[int64]{seg(inc(1), inc(6))},
+		},
+		{
+			name:     "[4;6] (1;3) [2;1]",
+			segments: []*OrderedSegment[int64]{seg(inc(4), inc(6)), seg(exc(1), exc(3)), seg(inc(2), inc(1))},
+			want:     []*OrderedSegment[int64]{seg(exc(1), exc(3)), seg(inc(4), inc(6))},
+		},
+		{
+			name:     "(inf;0) [0;0] (5;inf)",
+			segments: []*OrderedSegment[int64]{seg(unb(), exc(0)), seg(inc(0), inc(0)), seg(exc(5), unb())},
+			want:     []*OrderedSegment[int64]{seg(unb(), inc(0)), seg(exc(5), unb())},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewSegmentSet(tt.segments...).Segments(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegmentSet_Add(t *testing.T) {
+	type testCase[T ordered] struct {
+		name string
+		s    *SegmentSet[T]
+		seg  *OrderedSegment[T]
+		want string
+	}
+	tests := []testCase[int64]{
+		{
+			name: "into empty",
+			s:    set(),
+			seg:  seg(inc(1), inc(3)),
+			want: "{[1;3]}",
+		},
+		{
+			name: "adjacent to both",
+			s:    set(seg(inc(1), inc(3)), seg(inc(7), inc(9))),
+			seg:  seg(inc(4), inc(6)),
+			want: "{[1;9]}",
+		},
+		{
+			name: "between with gaps",
+			s:    set(seg(inc(1), inc(3)), seg(inc(7), inc(9))),
+			seg:  seg(inc(5), inc(5)),
+			want: "{[1;3], [5;5], [7;9]}",
+		},
+		{
+			name: "covers several",
+			s:    set(seg(inc(1), inc(3)), seg(inc(5), inc(6)), seg(inc(8), inc(9)), seg(inc(20), inc(30))),
+			seg:  seg(inc(2), exc(9)),
+			want: "{[1;9], [20;30]}",
+		},
+		{
+			name: "before all",
+			s:    set(seg(inc(5), inc(6))),
+			seg:  seg(unb(), exc(3)),
+			want: "{(-inf;3), [5;6]}",
+		},
+		{
+			name: "after all",
+			s:    set(seg(inc(5), inc(6))),
+			seg:  seg(exc(8), unb()),
+			want: "{[5;6], (8;+inf)}",
+		},
+		{
+			name: "empty segment",
+			s:    set(seg(inc(5), inc(6))),
+			seg:  seg(exc(1), exc(2)),
+			want: "{[5;6]}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.s.Add(tt.seg)
+			if got := tt.s.String(); got != tt.want {
+				t.Errorf("Add() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegmentSet_Remove(t *testing.T) {
+	type testCase[T ordered] struct {
+		name string
+		s    *SegmentSet[T]
+		seg  *OrderedSegment[T]
+		want string
+	}
+	tests := []testCase[int64]{
+		{
+			name: "split",
+			s:    set(seg(inc(1), inc(9))),
+			seg:  seg(inc(4), exc(6)),
+			want: "{[1;4), [6;9]}",
+		},
+		{
+			name: "across several",
+			s:    set(seg(inc(1), inc(3)), seg(inc(5), inc(6)), seg(inc(8), inc(9))),
+			seg:  seg(exc(2), inc(8)),
+			want: "{[1;2], (8;9]}",
+		},
+		{
+			name: "in a gap",
+			s:    set(seg(inc(1), inc(3)), seg(inc(8), inc(9))),
+			seg:  seg(exc(3), exc(8)),
+			want: "{[1;3], [8;9]}",
+		},
+		{
+			name: "everything",
+			s:    set(seg(inc(1), inc(3)), seg(inc(8), inc(9))),
+			seg:  seg(unb(), unb()),
+			want: "{}",
+		},
+		{
+			name: "from unbound",
+			s:    set(seg(unb(), unb())),
+			seg:  seg(inc(0), inc(0)),
+			want: "{(-inf;0), (0;+inf)}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.s.Remove(tt.seg)
+			if got := tt.s.String(); got != tt.want {
+				t.Errorf("Remove() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegmentSet_Complement(t *testing.T) {
+	type testCase[T ordered] struct {
+		name string
+		s    *SegmentSet[T]
+		want string
+	}
+	tests := []testCase[int64]{
+		{
+			name: "empty",
+			s:    set(),
+			want: "{(-inf;+inf)}",
+		},
+		{
+			name: "everything",
+			s:    set(seg(unb(), unb())),
+			want: "{}",
+		},
+		{
+			name: "two segments",
+			s:    set(seg(inc(1), inc(3)), seg(exc(5), unb())),
+			want: "{(-inf;1), (3;5]}",
+		},
+		{
+			name: "type limits",
+			s:    set(seg(inc(math.MinInt64), inc(3)), seg(inc(5), inc(math.MaxInt64))),
+			want: "{(3;5)}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Complement().String(); got != tt.want {
+				t.Errorf("Complement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegmentSet_Operations(t *testing.T) {
+	a := set(seg(inc(1), inc(5)), seg(inc(10), exc(20)), seg(exc(30), unb()))
+	b := set(seg(unb(), inc(2)), seg(inc(6), inc(12)), seg(inc(20), inc(35)))
+	tests := []struct {
+		name string
+		got  *SegmentSet[int64]
+		want string
+	}{
+		{name: "union", got: a.Union(b), want: "{(-inf;+inf)}"},
+		{name: "intersect", got: a.Intersect(b), want: "{[1;2], [10;12], (30;35]}"},
+		{name: "difference a-b", got: a.Difference(b), want: "{(2;5], (12;20), (35;+inf)}"},
+		{name: "difference b-a", got: b.Difference(a), want: "{(-inf;1), [6;10), [20;30]}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.String(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegmentSet_Contains(t *testing.T) {
+	s := set(seg(unb(), exc(-10)), seg(inc(1), inc(5)), seg(exc(10), exc(20)), seg(inc(30), unb()))
+	cases := map[int64]bool{
+		math.MinInt64: true,
+		-10:           false,
+		0:             false,
+		1:             true,
+		5:             true,
+		6:             false,
+		10:            false,
+		11:            true,
+		19:            true,
+		20:            false,
+		30:            true,
+		math.MaxInt64: true,
+	}
+	for point, want := range cases {
+		if got := s.Contains(point); got != want {
+			t.Errorf("Contains(%d) = %v, want %v", point, got, want)
+		}
+	}
+}
+
+func TestSegmentSet_ContainsSegment(t *testing.T) {
+	s := set(seg(inc(1), inc(5)), seg(exc(10), exc(20)))
+	tests := []struct {
+		seg  *OrderedSegment[int64]
+		want bool
+	}{
+		{seg: seg(inc(1), inc(5)), want: true},
+		{seg: seg(inc(2), exc(5)), want: true},
+		{seg: seg(inc(1), inc(6)), want: false},
+		{seg: seg(inc(11), inc(19)), want: true},
+		{seg: seg(inc(5), inc(11)), want: false},
+		{seg: seg(inc(10), inc(11)), want: false},
+		{seg: seg(exc(5), exc(6)), want: true},
+		{seg: seg(unb(), inc(2)), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.seg.String(), func(t *testing.T) {
+			if got := s.ContainsSegment(tt.seg); got != tt.want {
+				t.Errorf("ContainsSegment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSegmentSet_Random compares set operations with a brute force over a small domain.
+func TestSegmentSet_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomBorder := func() segment.Border[int64] {
+		switch r.Intn(5) {
+		case 0:
+			return unb()
+		case 1, 2:
+			return exc(r.Int63n(30))
+		}
+		return inc(r.Int63n(30))
+	}
+	randomSet := func() (*SegmentSet[int64], map[int64]bool) {
+		s := set()
+		values := make(map[int64]bool)
+		for n := r.Intn(5); n > 0; n-- {
+			seg := seg(randomBorder(), randomBorder())
+			remove := r.Intn(3) == 0
+			if remove {
+				s.Remove(seg)
+			} else {
+				s.Add(seg)
+			}
+			for v := int64(-5); v < 35; v++ {
+				if seg.IsIncludes(v) {
+					values[v] = !remove
+				}
+			}
+		}
+		return s, values
+	}
+	for i := 0; i < 500; i++ {
+		a, av := randomSet()
+		b, bv := randomSet()
+		union, intersect, diff, complement := a.Union(b), a.Intersect(b), a.Difference(b), a.Complement()
+		for _, s := range []*SegmentSet[int64]{a, b, union, intersect, diff, complement} {
+			if !reflect.DeepEqual(s.segments, normalize(s.Segments())) {
+				t.Fatalf("%v is not normalized", s)
+			}
+		}
+		for v := int64(-5); v < 35; v++ {
+			if got := a.Contains(v); got != av[v] {
+				t.Fatalf("%v.Contains(%d) = %v, want %v", a, v, got, av[v])
+			}
+			if got := union.Contains(v); got != (av[v] || bv[v]) {
+				t.Fatalf("%v ∪ %v = %v, Contains(%d) = %v", a, b, union, v, got)
+			}
+			if got := intersect.Contains(v); got != (av[v] && bv[v]) {
+				t.Fatalf("%v ∩ %v = %v, Contains(%d) = %v", a, b, intersect, v, got)
+			}
+			if got := diff.Contains(v); got != (av[v] && !bv[v]) {
+				t.Fatalf("%v \\ %v = %v, Contains(%d) = %v", a, b, diff, v, got)
+			}
+			if got := complement.Contains(v); got != !av[v] {
+				t.Fatalf("not %v = %v, Contains(%d) = %v", a, complement, v, got)
+			}
+		}
+	}
+}
+
+func TestSegmentSet_All(t *testing.T) {
+	set := NewSegmentSet(seg(inc(5), inc(7)), seg(inc(1), exc(3)))
+	var got []string
+	for s := range set.All() {
+		got = append(got, s.String())
+	}
+	if want := []string{"[1;3)", "[5;7]"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+}
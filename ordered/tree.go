@@ -0,0 +1,243 @@
+package ordered
+
+import (
+	"cmp"
+	gen "github.com/pioniro/generator-go"
+	"github.com/pioniro/segment-go"
+)
+
+// IntervalEntry is a segment with a payload, that is stored in an IntervalTree.
+type IntervalEntry[T ordered, P any] struct {
+	Segment segment.ISegment[T]
+	Payload P
+
+	id  uint64
+	seg *OrderedSegment[T]
+}
+
+// IntervalTree is an index of possibly overlapping segments with payloads.
+// It is a treap augmented with the maximal right border of each subtree,
+// so insert and delete take O(log n) and queries take O(log n + k) on average.
+//
+// The tree must not be modified while a query generator is running.
+type IntervalTree[T ordered, P any] struct {
+	root *treeNode[T, P]
+	size int
+	seq  uint64
+	// state of xorshift generator of node priorities
+	rnd uint64
+}
+
+type treeNode[T ordered, P any] struct {
+	entry    *IntervalEntry[T, P]
+	priority uint64
+	left     *treeNode[T, P]
+	right    *treeNode[T, P]
+	// maxTill is the maximal right border of the subtree
+	maxTill segment.Border[T]
+}
+
+func NewIntervalTree[T ordered, P any]() *IntervalTree[T, P] {
+	return &IntervalTree[T, P]{
+		rnd: 0x9E3779B97F4A7C15,
+	}
+}
+
+// Len returns a number of entries in a tree.
+func (t *IntervalTree[T, P]) Len() int {
+	return t.size
+}
+
+// Insert adds a segment with a payload to a tree and returns an entry, that can be used to delete it.
+// The same segment can be inserted several times.
+func (t *IntervalTree[T, P]) Insert(seg segment.ISegment[T], payload P) *IntervalEntry[T, P] {
+	t.seq++
+	entry := &IntervalEntry[T, P]{
+		Segment: seg,
+		Payload: payload,
+		id:      t.seq,
+		seg:     NewOrderedSegment(*seg.From(), *seg.Till()),
+	}
+	node := &treeNode[T, P]{
+		entry:    entry,
+		priority: t.random(),
+		maxTill:  entry.seg.till,
+	}
+	t.root = insertNode(t.root, node)
+	t.size++
+	return entry
+}
+
+// Delete removes an entry, that was returned by Insert. It returns false if there is no such entry in a tree.
+func (t *IntervalTree[T, P]) Delete(entry *IntervalEntry[T, P]) bool {
+	var deleted bool
+	t.root, deleted = deleteNode(t.root, entry)
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+// Containing returns a generator of all entries, whose segments include a point.
+// Entries are ordered by left borders of their segments.
+func (t *IntervalTree[T, P]) Containing(point T) gen.Generator[*IntervalEntry[T, P]] {
+	return func(yield gen.Yield[*IntervalEntry[T, P]]) {
+		var visit func(n *treeNode[T, P]) bool
+		visit = func(n *treeNode[T, P]) bool {
+			// nothing in the subtree reaches the point
+			if n == nil || !tillReaches(n.maxTill, point) {
+				return true
+			}
+			if !visit(n.left) {
+				return false
+			}
+			// the node and its right subtree start after the point
+			if !fromReaches(n.entry.seg.from, point) {
+				return true
+			}
+			if n.entry.seg.IsIncludes(point) && !yield(n.entry, nil) {
+				return false
+			}
+			return visit(n.right)
+		}
+		visit(t.root)
+	}
+}
+
+// Overlapping returns a generator of all entries, whose segments have common values with a given segment.
+// Entries are ordered by left borders of their segments.
+func (t *IntervalTree[T, P]) Overlapping(seg segment.ISegment[T]) gen.Generator[*IntervalEntry[T, P]] {
+	return func(yield gen.Yield[*IntervalEntry[T, P]]) {
+		s := NewOrderedSegment(*seg.From(), *seg.Till())
+		if s.IsEmpty() {
+			return
+		}
+		var visit func(n *treeNode[T, P]) bool
+		visit = func(n *treeNode[T, P]) bool {
+			// nothing in the subtree reaches the segment
+			if n == nil || !overlaps(n.maxTill, s.from) {
+				return true
+			}
+			if !visit(n.left) {
+				return false
+			}
+			// the node and its right subtree start after the segment
+			if !overlaps(s.till, n.entry.seg.from) {
+				return true
+			}
+			if _, ok := n.entry.seg.Intersect(s); ok && !yield(n.entry, nil) {
+				return false
+			}
+			return visit(n.right)
+		}
+		visit(t.root)
+	}
+}
+
+// random returns a next priority of a node (xorshift64).
+func (t *IntervalTree[T, P]) random() uint64 {
+	t.rnd ^= t.rnd << 13
+	t.rnd ^= t.rnd >> 7
+	t.rnd ^= t.rnd << 17
+	return t.rnd
+}
+
+// fromReaches returns true if a left border is not after a point.
+func fromReaches[T ordered](from segment.Border[T], point T) bool {
+	return segment.IncludesFrom(from, point, cmp.Compare[T])
+}
+
+// tillReaches returns true if a right border is not before a point.
+func tillReaches[T ordered](till segment.Border[T], point T) bool {
+	return segment.IncludesTill(till, point, cmp.Compare[T])
+}
+
+// lessEntry orders entries by left borders of their segments, and by insertion order for equal borders.
+func lessEntry[T ordered, P any](a, b *IntervalEntry[T, P]) bool {
+	if c := compareFrom(a.seg.from, b.seg.from); c != 0 {
+		return c < 0
+	}
+	return a.id < b.id
+}
+
+func (n *treeNode[T, P]) update() {
+	n.maxTill = n.entry.seg.till
+	if n.left != nil && compareTill(n.left.maxTill, n.maxTill) > 0 {
+		n.maxTill = n.left.maxTill
+	}
+	if n.right != nil && compareTill(n.right.maxTill, n.maxTill) > 0 {
+		n.maxTill = n.right.maxTill
+	}
+}
+
+func rotateRight[T ordered, P any](n *treeNode[T, P]) *treeNode[T, P] {
+	l := n.left
+	n.left = l.right
+	n.update()
+	l.right = n
+	l.update()
+	return l
+}
+
+func rotateLeft[T ordered, P any](n *treeNode[T, P]) *treeNode[T, P] {
+	r := n.right
+	n.right = r.left
+	n.update()
+	r.left = n
+	r.update()
+	return r
+}
+
+func insertNode[T ordered, P any](n, node *treeNode[T, P]) *treeNode[T, P] {
+	if n == nil {
+		return node
+	}
+	if lessEntry(node.entry, n.entry) {
+		n.left = insertNode(n.left, node)
+		if n.left.priority > n.priority {
+			return rotateRight(n)
+		}
+	} else {
+		n.right = insertNode(n.right, node)
+		if n.right.priority > n.priority {
+			return rotateLeft(n)
+		}
+	}
+	n.update()
+	return n
+}
+
+func deleteNode[T ordered, P any](n *treeNode[T, P], entry *IntervalEntry[T, P]) (*treeNode[T, P], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var deleted bool
+	switch {
+	case n.entry == entry:
+		return joinNodes(n.left, n.right), true
+	case lessEntry(entry, n.entry):
+		n.left, deleted = deleteNode(n.left, entry)
+	default:
+		n.right, deleted = deleteNode(n.right, entry)
+	}
+	n.update()
+	return n, deleted
+}
+
+// joinNodes joins two subtrees, where all entries of the left one are less than entries of the right one.
+func joinNodes[T ordered, P any](left, right *treeNode[T, P]) *treeNode[T, P] {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	case left.priority > right.priority:
+		left.right = joinNodes(left.right, right)
+		left.update()
+		return left
+	default:
+		right.left = joinNodes(left, right.left)
+		right.update()
+		return right
+	}
+}
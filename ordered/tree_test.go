@@ -0,0 +1,160 @@
+package ordered
+
+import (
+	"github.com/pioniro/segment-go"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func collectPayloads[T ordered, P any](entries []*IntervalEntry[T, P]) []P {
+	var result []P
+	for _, e := range entries {
+		result = append(result, e.Payload)
+	}
+	return result
+}
+
+func TestIntervalTree_Containing(t *testing.T) {
+	tree := NewIntervalTree[int64, string]()
+	tree.Insert(seg(inc(1), inc(5)), "[1;5]")
+	tree.Insert(seg(exc(3), exc(8)), "(3;8)")
+	tree.Insert(seg(unb(), exc(3)), "(inf;3)")
+	tree.Insert(seg(inc(8), unb()), "[8;inf)")
+	tree.Insert(seg(exc(1), exc(2)), "(1;2)")
+	tests := []struct {
+		point int64
+		want  []string
+	}{
+		{point: 0, want: []string{"(inf;3)"}},
+		{point: 1, want: []string{"(inf;3)", "[1;5]"}},
+		{point: 3, want: []string{"[1;5]"}},
+		{point: 4, want: []string{"[1;5]", "(3;8)"}},
+		{point: 8, want: []string{"[8;inf)"}},
+		{point: 100, want: []string{"[8;inf)"}},
+	}
+	for _, tt := range tests {
+		t.Run(NewTestValue(tt.point).String(), func(t *testing.T) {
+			if got := collectPayloads(tree.Containing(tt.point).Collect()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Containing() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntervalTree_Overlapping(t *testing.T) {
+	tree := NewIntervalTree[int64, string]()
+	tree.Insert(seg(inc(1), inc(5)), "[1;5]")
+	tree.Insert(seg(exc(3), exc(8)), "(3;8)")
+	tree.Insert(seg(unb(), exc(3)), "(inf;3)")
+	tree.Insert(seg(inc(8), unb()), "[8;inf)")
+	tests := []struct {
+		name string
+		seg  segment.ISegment[int64]
+		want []string
+	}{
+		{name: "[5;8)", seg: seg(inc(5), exc(8)), want: []string{"[1;5]", "(3;8)"}},
+		{name: "(5;8)", seg: seg(exc(5), exc(8)), want: []string{"(3;8)"}},
+		{name: "[3;3]", seg: seg(inc(3), inc(3)), want: []string{"[1;5]"}},
+		{name: "(2;4)", seg: seg(exc(2), exc(4)), want: []string{"[1;5]"}},
+		{name: "[2;4)", seg: seg(inc(2), exc(4)), want: []string{"(inf;3)", "[1;5]"}},
+		{name: "(inf;inf)", seg: seg(unb(), unb()), want: []string{"(inf;3)", "[1;5]", "(3;8)", "[8;inf)"}},
+		{name: "(4;5)", seg: seg(exc(4), exc(5)), want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := collectPayloads(tree.Overlapping(tt.seg).Collect()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Overlapping() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntervalTree_Interrupt(t *testing.T) {
+	tree := NewIntervalTree[int64, int]()
+	for i := 0; i < 10; i++ {
+		tree.Insert(seg(inc(0), inc(10)), i)
+	}
+	var got []int
+	tree.Containing(5)(func(e *IntervalEntry[int64, int], err error) bool {
+		got = append(got, e.Payload)
+		return len(got) < 3
+	})
+	if want := []int{0, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Containing() = %v, want %v", got, want)
+	}
+}
+
+func TestIntervalTree_Delete(t *testing.T) {
+	tree := NewIntervalTree[int64, string]()
+	a := tree.Insert(seg(inc(1), inc(5)), "a")
+	b := tree.Insert(seg(inc(1), inc(5)), "b")
+	if !tree.Delete(a) {
+		t.Fatalf("Delete() = false, want true")
+	}
+	if tree.Delete(a) {
+		t.Fatalf("second Delete() = true, want false")
+	}
+	if got, want := collectPayloads(tree.Containing(3).Collect()), []string{"b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Containing() = %v, want %v", got, want)
+	}
+	tree.Delete(b)
+	if tree.Len() != 0 || tree.Containing(3).Collect() != nil {
+		t.Errorf("tree is not empty after deleting all entries")
+	}
+}
+
+// TestIntervalTree_Random compares queries with a brute force over all inserted segments.
+func TestIntervalTree_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomBorder := func() segment.Border[int64] {
+		switch r.Intn(6) {
+		case 0:
+			return unb()
+		case 1, 2:
+			return exc(r.Int63n(100))
+		}
+		return inc(r.Int63n(100))
+	}
+	tree := NewIntervalTree[int64, int]()
+	var entries []*IntervalEntry[int64, int]
+	for i := 0; i < 2000; i++ {
+		if len(entries) > 0 && r.Intn(3) == 0 {
+			k := r.Intn(len(entries))
+			tree.Delete(entries[k])
+			entries = append(entries[:k], entries[k+1:]...)
+		} else {
+			entries = append(entries, tree.Insert(seg(randomBorder(), randomBorder()), i))
+		}
+		if i%50 != 0 {
+			continue
+		}
+		if tree.Len() != len(entries) {
+			t.Fatalf("Len() = %d, want %d", tree.Len(), len(entries))
+		}
+		point := r.Int63n(110) - 5
+		query := seg(randomBorder(), randomBorder())
+		wantContaining, wantOverlapping := map[int]bool{}, map[int]bool{}
+		for _, e := range entries {
+			if e.seg.IsIncludes(point) {
+				wantContaining[e.Payload] = true
+			}
+			if _, ok := e.seg.Intersect(query); ok {
+				wantOverlapping[e.Payload] = true
+			}
+		}
+		gotContaining, gotOverlapping := map[int]bool{}, map[int]bool{}
+		for _, p := range collectPayloads(tree.Containing(point).Collect()) {
+			gotContaining[p] = true
+		}
+		for _, p := range collectPayloads(tree.Overlapping(query).Collect()) {
+			gotOverlapping[p] = true
+		}
+		if !reflect.DeepEqual(gotContaining, wantContaining) {
+			t.Fatalf("Containing(%d) = %v, want %v", point, gotContaining, wantContaining)
+		}
+		if !reflect.DeepEqual(gotOverlapping, wantOverlapping) {
+			t.Fatalf("Overlapping(%v) = %v, want %v", query, gotOverlapping, wantOverlapping)
+		}
+	}
+}
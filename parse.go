@@ -0,0 +1,194 @@
+package segment
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ValueParser parses a string representation of a value, for example "1" -> Int(1).
+type ValueParser[T any] func(string) (Value[T], error)
+
+// ParseError is an error of parsing a segment from a string. Pos is a byte offset in Input, where the error occurred.
+type ParseError struct {
+	Input string
+	Pos   int
+	Msg   string
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("segment: cannot parse %q at position %d: %s: %v", e.Input, e.Pos, e.Msg, e.Err)
+	}
+	return fmt.Sprintf("segment: cannot parse %q at position %d: %s", e.Input, e.Pos, e.Msg)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// infSpellings are spellings of infinity, that are accepted by ParseBorders, and a sign of each of them.
+var infSpellings = map[string]int{
+	"inf":  0,
+	"-inf": -1,
+	"+inf": 1,
+	"∞":    0,
+	"-∞":   -1,
+	"+∞":   1,
+}
+
+// ParseBorders parses borders of a segment in interval notation: [1;2), (1, 2], (-inf;5], [1;∞).
+// Values are separated by ';' or ',' and can be surrounded by whitespaces.
+// Infinity can be written as inf, -inf, +inf, ∞, -∞ or +∞, and it makes a border Unbound,
+// but a left border can't be +inf and a right border can't be -inf.
+// A value can be quoted in Go syntax to contain separators and brackets: ["a;b";"c)"]. It is unquoted
+// before parsing, so a quoted infinity is a value: ("-inf";"+Inf") of floats is not Unbound.
+// A string representation of a segment, see Format, can always be parsed back.
+func ParseBorders[T any](s string, parse ValueParser[T]) (Border[T], Border[T], error) {
+	var from, till Border[T]
+	fail := func(pos int, msg string, err error) (Border[T], Border[T], error) {
+		return from, till, &ParseError{Input: s, Pos: pos, Msg: msg, Err: err}
+	}
+	start := skipSpaces(s, 0)
+	if start == len(s) {
+		return fail(start, "empty segment", nil)
+	}
+	if s[start] != '[' && s[start] != '(' {
+		return fail(start, "expected '[' or '('", nil)
+	}
+	trimmed := len(strings.TrimRightFunc(s, unicode.IsSpace))
+	end := strings.LastIndexAny(s[:trimmed], "])")
+	if end <= start {
+		return fail(trimmed, "expected ']' or ')'", nil)
+	}
+	if end != trimmed-1 {
+		return fail(end+1, "unexpected characters after a segment", nil)
+	}
+	sep := separator(s, start+1, end)
+	if sep < 0 {
+		return fail(end, "expected ';' or ','", nil)
+	}
+
+	fromBound, tillBound := Excluded, Excluded
+	if s[start] == '[' {
+		fromBound = Included
+	}
+	if s[end] == ']' {
+		tillBound = Included
+	}
+	var err error
+	var pos int
+	if from, pos, err = parseBorder(s, start+1, sep, fromBound, -1, parse); err != nil {
+		return fail(pos, "invalid left border", err)
+	}
+	if till, pos, err = parseBorder(s, sep+1, end, tillBound, 1, parse); err != nil {
+		return fail(pos, "invalid right border", err)
+	}
+	return from.AsFrom(), till.AsTill(), nil
+}
+
+// Format returns a segment in interval notation, that can be parsed back with ParseBorders:
+// [1;2], (1;2], [1;+inf), (-inf;2), (-inf;+inf)
+// Values, that are empty, contain separators, brackets or quotes, have spaces around or are spelled as an infinity,
+// are quoted with strconv.Quote: ["a;b";"+Inf"].
+func Format[T any](s ISegment[T]) string {
+	from, till := s.From().AsFrom(), s.Till().AsTill()
+	leftBound, rightBound := "(", ")"
+	if from.IsIncluded() {
+		leftBound = "["
+	}
+	if till.IsIncluded() {
+		rightBound = "]"
+	}
+	return leftBound + formatBorder(from) + ";" + formatBorder(till) + rightBound
+}
+
+// formatBorder returns a value of a border, quoted if it can't be parsed back as is, or an infinity of an unbound border.
+func formatBorder[T any](b Border[T]) string {
+	if b.IsUnbound() {
+		return b.String()
+	}
+	v := b.String()
+	_, inf := infSpellings[strings.ToLower(v)]
+	if inf || v == "" || strings.ContainsAny(v, `;,[]()"`) || strings.TrimSpace(v) != v {
+		return strconv.Quote(v)
+	}
+	return v
+}
+
+// separator returns a position of the first ';' in s[start:end] or of the first ',' if there is no ';'.
+// Separators in quoted values are skipped. If there is no separator, then -1 is returned.
+func separator(s string, start, end int) int {
+	semicolon, comma := -1, -1
+	for i, quoted := start, false; i < end && semicolon < 0; i++ {
+		switch c := s[i]; {
+		case quoted && c == '\\':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == ';':
+			semicolon = i
+		case !quoted && c == ',' && comma < 0:
+			comma = i
+		}
+	}
+	if semicolon >= 0 {
+		return semicolon
+	}
+	return comma
+}
+
+// parseBorder parses a value s[start:end] of a border. side is -1 for a left border, 1 for a right one
+// and 0 for a standalone border, that can be an infinity of any sign.
+// In case of an error, a position of the value is returned.
+func parseBorder[T any](s string, start, end int, bound Bound, side int, parse ValueParser[T]) (Border[T], int, error) {
+	start = skipSpaces(s, start)
+	raw := strings.TrimRightFunc(s[start:end], unicode.IsSpace)
+	if raw == "" {
+		return Border[T]{}, start, fmt.Errorf("missing value")
+	}
+	if raw[0] == '"' {
+		unquoted, err := strconv.Unquote(raw)
+		if err != nil {
+			return Border[T]{}, start, fmt.Errorf("invalid quoted value %s: %w", raw, err)
+		}
+		value, err := parse(unquoted)
+		if err != nil {
+			return Border[T]{}, start, err
+		}
+		return NewBorder(bound, value), start, nil
+	}
+	if sign, ok := infSpellings[strings.ToLower(raw)]; ok {
+		if side != 0 && sign == -side {
+			return Border[T]{}, start, fmt.Errorf("%s can't be on this side of a segment", raw)
+		}
+		switch sign {
+		case -1:
+			return NewLeftUnbound[T](), start, nil
+		case 1:
+			return NewRightUnbound[T](), start, nil
+		}
+		return NewUnbound[T](), start, nil
+	}
+	value, err := parse(raw)
+	if err != nil {
+		return Border[T]{}, start, err
+	}
+	return NewBorder(bound, value), start, nil
+}
+
+// skipSpaces returns a position of the first rune after pos, that is not a space.
+// Runes are decoded, so a byte of a multibyte rune is never taken for a space.
+func skipSpaces(s string, pos int) int {
+	for pos < len(s) {
+		r, size := utf8.DecodeRuneInString(s[pos:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		pos += size
+	}
+	return pos
+}
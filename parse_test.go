@@ -0,0 +1,164 @@
+package segment
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func parseTestValue(s string) (Value[int64], error) {
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return NewTestValue(v), nil
+}
+
+func TestParseBorders(t *testing.T) {
+	tests := []struct {
+		input    string
+		wantFrom string
+		wantTill string
+		fromInc  bool
+		tillInc  bool
+	}{
+		{input: "[1;2)", wantFrom: "1", wantTill: "2", fromInc: true},
+		{input: "(1;2]", wantFrom: "1", wantTill: "2", tillInc: true},
+		{input: "[1, 2]", wantFrom: "1", wantTill: "2", fromInc: true, tillInc: true},
+		{input: "  ( -5 ;\t10 )  ", wantFrom: "-5", wantTill: "10"},
+		{input: "\u00a0[1;2]", wantFrom: "1", wantTill: "2", fromInc: true, tillInc: true},
+		{input: "[\u00a01;\u00a02]\u00a0", wantFrom: "1", wantTill: "2", fromInc: true, tillInc: true},
+		{input: "(-inf;5]", wantFrom: "-inf", wantTill: "5", tillInc: true},
+		{input: "(inf;inf)", wantFrom: "-inf", wantTill: "+inf"},
+		{input: "[1;+inf)", wantFrom: "1", wantTill: "+inf", fromInc: true},
+		{input: "(-∞, ∞)", wantFrom: "-inf", wantTill: "+inf"},
+		{input: "(+∞;+∞)", wantFrom: "", wantTill: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			from, till, err := ParseBorders(tt.input, parseTestValue)
+			if tt.wantFrom == "" {
+				if err == nil {
+					t.Fatalf("ParseBorders() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseBorders() error = %v", err)
+			}
+			if from.String() != tt.wantFrom || till.String() != tt.wantTill {
+				t.Errorf("ParseBorders() = %v, %v, want %v, %v", from.String(), till.String(), tt.wantFrom, tt.wantTill)
+			}
+			if from.IsIncluded() != tt.fromInc || till.IsIncluded() != tt.tillInc {
+				t.Errorf("ParseBorders() included = %v, %v, want %v, %v", from.IsIncluded(), till.IsIncluded(), tt.fromInc, tt.tillInc)
+			}
+		})
+	}
+}
+
+func TestParseBorders_Errors(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantPos int
+		wantErr error
+	}{
+		{input: "", wantPos: 0},
+		{input: "   ", wantPos: 3},
+		{input: "1;2]", wantPos: 0},
+		{input: "[1;2", wantPos: 4},
+		{input: "[1;2] x", wantPos: 5},
+		{input: "[1 2]", wantPos: 4},
+		{input: "[;2]", wantPos: 1},
+		{input: "[1;  ]", wantPos: 5},
+		{input: "[1;abc]", wantPos: 3, wantErr: strconv.ErrSyntax},
+		{input: "[ 99999999999999999999; 1]", wantPos: 2, wantErr: strconv.ErrRange},
+		{input: "(+inf;1]", wantPos: 1},
+		{input: "[1;-inf)", wantPos: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, _, err := ParseBorders(tt.input, parseTestValue)
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ParseBorders() error = %v, want ParseError", err)
+			}
+			if parseErr.Pos != tt.wantPos {
+				t.Errorf("ParseBorders() error position = %d, want %d (%v)", parseErr.Pos, tt.wantPos, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseBorders() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		seg  *Segment[int64]
+		want string
+	}{
+		{seg: NewSegment(NewIncluded(NewTestValue(1)), NewExcluded(NewTestValue(2))), want: "[1;2)"},
+		{seg: NewSegment(NewUnbound[int64](), NewIncluded(NewTestValue(2))), want: "(-inf;2]"},
+		{seg: &Segment[int64]{F: NewUnbound[int64](), T: NewUnbound[int64]()}, want: "(-inf;+inf)"},
+	}
+	for _, tt := range tests {
+		if got := Format[int64](tt.seg); got != tt.want {
+			t.Errorf("Format() = %s, want %s", got, tt.want)
+		}
+		from, till, err := ParseBorders(tt.want, parseTestValue)
+		if err != nil || Format[int64](NewSegment(from, till)) != tt.want {
+			t.Errorf("ParseBorders(Format()) = %v, want %s", err, tt.want)
+		}
+	}
+}
+
+func TestFormat_Quoted(t *testing.T) {
+	name := func(v string) Value[testString] { return &testName[testString]{testString(v)} }
+	parse := func(s string) (Value[testString], error) { return name(s), nil }
+	registerValue(t, func(v testString) Value[testString] { return name(string(v)) }, parse)
+	tests := []struct {
+		from, till string
+		want       string
+	}{
+		{from: "a", till: "b", want: "[a;b)"},
+		{from: "a;b", till: "c,d", want: `["a;b";"c,d")`},
+		{from: "x]", till: "(y", want: `["x]";"(y")`},
+		{from: "", till: " pad", want: `["";" pad")`},
+		{from: "-inf", till: "∞", want: `["-inf";"∞")`},
+		{from: `q"uote`, till: `back\slash`, want: `["q\"uote";back\slash)`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			seg := NewSegment(NewIncluded(name(tt.from)), NewExcluded(name(tt.till)))
+			got := Format[testString](seg)
+			if got != tt.want {
+				t.Errorf("Format() = %s, want %s", got, tt.want)
+			}
+			from, till, err := ParseBorders(got, parse)
+			if err != nil {
+				t.Fatalf("ParseBorders(%s) error = %v", got, err)
+			}
+			if from.Value().Value() != testString(tt.from) || till.Value().Value() != testString(tt.till) || !from.IsIncluded() || !till.IsExcluded() {
+				t.Errorf("ParseBorders(%s) = %v, %v, want %q, %q", got, from.Value(), till.Value(), tt.from, tt.till)
+			}
+			text, err := seg.F.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error = %v", err)
+			}
+			var b Border[testString]
+			if err := b.UnmarshalText(text); err != nil || b.Value().Value() != testString(tt.from) {
+				t.Errorf("UnmarshalText(%s) = %v, %v, want %q", text, b.Value(), err, tt.from)
+			}
+		})
+	}
+	// a comma in a quoted value is not a separator, even if there is no semicolon
+	from, till, err := ParseBorders(`("a,b", c]`, parse)
+	if err != nil || from.Value().Value() != "a,b" || till.Value().Value() != "c" {
+		t.Errorf("ParseBorders() = %v, %v, %v, want a,b and c", from.Value(), till.Value(), err)
+	}
+	for _, input := range []string{`["a;b]`, `["a\q";b]`} {
+		if _, _, err := ParseBorders(input, parse); err == nil {
+			t.Errorf("ParseBorders(%s) error = nil", input)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package pgrange
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"github.com/pioniro/segment-go"
+	segment_int "github.com/pioniro/segment-go/integers"
+	"github.com/pioniro/segment-go/ordered"
+)
+
+type intLike interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// IntRange is an IntSegment, that can be read from and written to int4range and int8range columns.
+// It is a conversion of a pointer: db.Exec(query, (*pgrange.IntRange[int64])(seg)).
+// A NULL is scanned as a nil OrderedSegment, and an empty range as [0;0).
+type IntRange[T intLike] segment_int.IntSegment[T]
+
+// IntMultirange is a set of segments, that can be read from and written to int4multirange and int8multirange columns.
+// A set can't be NULL, so a NULL is scanned as an empty set and is written back as '{}'.
+// To keep a NULL, scan into a pointer: a nil *IntMultirange is scanned from NULL by database/sql and is written as NULL.
+type IntMultirange[T intLike] ordered.SegmentSet[T]
+
+// Segment returns a range as an IntSegment.
+func (r *IntRange[T]) Segment() *segment_int.IntSegment[T] {
+	return (*segment_int.IntSegment[T])(r)
+}
+
+// Scan implements sql.Scanner.
+func (r *IntRange[T]) Scan(src any) error {
+	if src == nil {
+		r.OrderedSegment = nil
+		return nil
+	}
+	s, err := asString(src)
+	if err != nil {
+		return err
+	}
+	rng, err := ParseRange(s, segment_int.ParseInt[T])
+	if err != nil {
+		return err
+	}
+	r.OrderedSegment = intSegment(rng).OrderedSegment
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (r *IntRange[T]) Value() (driver.Value, error) {
+	if r == nil || r.OrderedSegment == nil {
+		return nil, nil
+	}
+	return FormatRange(toRange[T](r.OrderedSegment)), nil
+}
+
+// Set returns a multirange as a set of segments.
+func (m *IntMultirange[T]) Set() *ordered.SegmentSet[T] {
+	return (*ordered.SegmentSet[T])(m)
+}
+
+// Scan implements sql.Scanner. A NULL is scanned as an empty set, see IntMultirange.
+func (m *IntMultirange[T]) Scan(src any) error {
+	if src == nil {
+		*m = IntMultirange[T](*ordered.NewSegmentSet[T]())
+		return nil
+	}
+	s, err := asString(src)
+	if err != nil {
+		return err
+	}
+	ranges, err := ParseMultirange(s, segment_int.ParseInt[T])
+	if err != nil {
+		return err
+	}
+	segments := make([]*segment_int.IntSegment[T], len(ranges))
+	for i, rng := range ranges {
+		segments[i] = intSegment(rng)
+	}
+	*m = IntMultirange[T](*segment_int.NewIntSegmentSet(segments...))
+	return nil
+}
+
+// Value implements driver.Valuer. A nil multirange is written as NULL, and an empty one as '{}'.
+func (m *IntMultirange[T]) Value() (driver.Value, error) {
+	if m == nil {
+		return nil, nil
+	}
+	segments := m.Set().Segments()
+	ranges := make([]Range[T], len(segments))
+	for i, seg := range segments {
+		ranges[i] = toRange[T](seg)
+	}
+	return FormatMultirange(ranges), nil
+}
+
+func intSegment[T intLike](r Range[T]) *segment_int.IntSegment[T] {
+	if r.Empty {
+		return segment_int.NewIntSegment(segment.NewIncluded(segment_int.Int[T](0)), segment.NewExcluded(segment_int.Int[T](0)))
+	}
+	return segment_int.NewIntSegment(r.From, r.Till)
+}
+
+func toRange[T intLike](s *ordered.OrderedSegment[T]) Range[T] {
+	if s.IsEmpty() {
+		return Range[T]{Empty: true}
+	}
+	return Range[T]{From: *s.From(), Till: *s.Till()}
+}
+
+func asString(src any) (string, error) {
+	switch v := src.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	}
+	return "", fmt.Errorf("pgrange: cannot scan %T into a range", src)
+}
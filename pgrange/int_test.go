@@ -0,0 +1,116 @@
+package pgrange
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"github.com/pioniro/segment-go"
+	segment_int "github.com/pioniro/segment-go/integers"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*IntRange[int64])(nil)
+	_ driver.Valuer = (*IntRange[int64])(nil)
+	_ sql.Scanner   = (*IntMultirange[int32])(nil)
+	_ driver.Valuer = (*IntMultirange[int32])(nil)
+)
+
+func TestIntRange_Scan(t *testing.T) {
+	tests := []struct {
+		src       any
+		want      string
+		wantEmpty bool
+	}{
+		{src: "[1,10)", want: "[1;10)"},
+		{src: []byte("(,5]"), want: "(-inf;5]"},
+		{src: "[-9223372036854775808,)", want: "[-9223372036854775808;+inf)"},
+		{src: "empty", wantEmpty: true},
+	}
+	for _, tt := range tests {
+		var r IntRange[int64]
+		if err := r.Scan(tt.src); err != nil {
+			t.Fatalf("Scan(%s) error = %v", tt.src, err)
+		}
+		if tt.wantEmpty {
+			if !r.Segment().IsEmpty() {
+				t.Errorf("Scan(%s) = %s, want an empty segment", tt.src, r.String())
+			}
+			continue
+		}
+		if r.String() != tt.want {
+			t.Errorf("Scan(%s) = %s, want %s", tt.src, r.String(), tt.want)
+		}
+	}
+
+	var r IntRange[int64]
+	if err := r.Scan(nil); err != nil || r.OrderedSegment != nil {
+		t.Errorf("Scan(nil) = %v, %v, want nil segment", r.OrderedSegment, err)
+	}
+	if v, err := r.Value(); err != nil || v != nil {
+		t.Errorf("Value() of NULL = %v, %v, want nil", v, err)
+	}
+	for _, src := range []any{42, "[1,10", "[1,1000)"} {
+		var small IntRange[int8]
+		if err := small.Scan(src); err == nil {
+			t.Errorf("Scan(%v) error = nil", src)
+		}
+	}
+}
+
+func TestIntRange_Value(t *testing.T) {
+	tests := []struct {
+		seg  *segment_int.IntSegment[int64]
+		want string
+	}{
+		{seg: segment_int.MustParse[int64]("[1;10)"), want: "[1,10)"},
+		{seg: segment_int.MustParse[int64]("(-inf;5]"), want: "(,5]"},
+		{seg: segment_int.MustParse[int64]("(1;+inf)"), want: "(1,)"},
+		{seg: segment_int.MustParse[int64]("(-inf;+inf)"), want: "(,)"},
+		{seg: segment_int.MustParse[int64]("(1;2)"), want: "empty"},
+	}
+	for _, tt := range tests {
+		v, err := (*IntRange[int64])(tt.seg).Value()
+		if err != nil || v != tt.want {
+			t.Errorf("Value(%s) = %v, %v, want %s", tt.seg, v, err, tt.want)
+		}
+	}
+}
+
+func TestIntMultirange(t *testing.T) {
+	var m IntMultirange[int32]
+	if err := m.Scan("{[1,3),[3,5],[10,)}"); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if got := m.Set().String(); got != "{[1;5], [10;+inf)}" {
+		t.Errorf("Scan() = %s, want {[1;5], [10;+inf)}", got)
+	}
+	if !m.Set().Contains(4) || m.Set().Contains(7) {
+		t.Errorf("Scan() produced a wrong set %s", m.Set())
+	}
+	v, err := m.Value()
+	if err != nil || v != "{[1,5],[10,)}" {
+		t.Errorf("Value() = %v, %v, want {[1,5],[10,)}", v, err)
+	}
+
+	if err := m.Scan(nil); err != nil || !m.Set().IsEmpty() {
+		t.Errorf("Scan(nil) = %s, %v, want an empty set", m.Set(), err)
+	}
+	if v, err := m.Value(); err != nil || v != "{}" {
+		t.Errorf("Value() of an empty set = %v, %v, want {}", v, err)
+	}
+	// a NULL is kept with a nil pointer
+	var null *IntMultirange[int32]
+	if v, err := null.Value(); err != nil || v != nil {
+		t.Errorf("Value() of a nil multirange = %v, %v, want nil", v, err)
+	}
+
+	set := segment_int.NewIntSegmentSet(
+		segment_int.NewIntSegment(segment.NewUnbound[int32](), segment.NewExcluded(segment_int.Int[int32](0))),
+	)
+	if v, err := (*IntMultirange[int32])(set).Value(); err != nil || v != "{(,0)}" {
+		t.Errorf("Value() = %v, %v, want {(,0)}", v, err)
+	}
+	if err := m.Scan([]byte("{[1,2)")); err == nil {
+		t.Errorf("Scan() of an invalid literal error = nil")
+	}
+}
@@ -0,0 +1,259 @@
+// Package pgrange reads and writes segments in PostgreSQL range and multirange literal syntax:
+// [1,10), (,5], empty, ["a b","c"), {[1,3),[5,7)}.
+//
+// IntRange and IntMultirange implement sql.Scanner and driver.Valuer for int4range, int8range and their multiranges,
+// TimeRange does it for tstzrange and tsrange.
+// Ranges of other types are parsed with ParseRange and a segment.ValueParser of their elements.
+package pgrange
+
+import (
+	"errors"
+	"github.com/pioniro/segment-go"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Range is a PostgreSQL range: borders of a segment or an empty range.
+// Included, Excluded and Unbound borders map one-to-one onto inclusive, exclusive and infinite bounds of Postgres.
+type Range[T any] struct {
+	From  segment.Border[T]
+	Till  segment.Border[T]
+	Empty bool
+}
+
+// ParseRange parses a range literal: [1,10), (1,10], (,5], [1,), (,), empty.
+// Elements can be quoted with double quotes and escaped with a backslash: ["a,b","c\"d").
+// Whitespaces around unquoted elements are ignored.
+func ParseRange[T any](s string, parse segment.ValueParser[T]) (Range[T], error) {
+	r, pos, err := parseRange(s, skipSpaces(s, 0), parse)
+	if err != nil {
+		return r, err
+	}
+	if pos = skipSpaces(s, pos); pos != len(s) {
+		return r, &segment.ParseError{Input: s, Pos: pos, Msg: "unexpected characters after a range"}
+	}
+	return r, nil
+}
+
+// ParseMultirange parses a multirange literal: {}, {[1,3),[5,7)}. Empty ranges of a multirange are skipped.
+func ParseMultirange[T any](s string, parse segment.ValueParser[T]) ([]Range[T], error) {
+	fail := func(pos int, msg string) ([]Range[T], error) {
+		return nil, &segment.ParseError{Input: s, Pos: pos, Msg: msg}
+	}
+	pos := skipSpaces(s, 0)
+	if pos == len(s) || s[pos] != '{' {
+		return fail(pos, "expected '{'")
+	}
+	var result []Range[T]
+	pos = skipSpaces(s, pos+1)
+	if pos < len(s) && s[pos] == '}' {
+		pos++
+	} else {
+		for {
+			r, next, err := parseRange(s, pos, parse)
+			if err != nil {
+				return nil, err
+			}
+			if !r.Empty {
+				result = append(result, r)
+			}
+			pos = skipSpaces(s, next)
+			if pos < len(s) && s[pos] == ',' {
+				pos = skipSpaces(s, pos+1)
+				continue
+			}
+			if pos < len(s) && s[pos] == '}' {
+				pos++
+				break
+			}
+			return fail(pos, "expected ',' or '}'")
+		}
+	}
+	if pos = skipSpaces(s, pos); pos != len(s) {
+		return fail(pos, "unexpected characters after a multirange")
+	}
+	return result, nil
+}
+
+// FormatRange formats a range the same way as Postgres does: [1,10), (,5], empty.
+// Elements are quoted if they are empty or contain special characters.
+func FormatRange[T any](r Range[T]) string {
+	var sb strings.Builder
+	writeRange(&sb, r)
+	return sb.String()
+}
+
+// FormatMultirange formats a multirange: {[1,3),[5,7)}. Empty ranges are skipped.
+func FormatMultirange[T any](ranges []Range[T]) string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	first := true
+	for _, r := range ranges {
+		if r.Empty {
+			continue
+		}
+		if !first {
+			sb.WriteByte(',')
+		}
+		first = false
+		writeRange(&sb, r)
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+func writeRange[T any](sb *strings.Builder, r Range[T]) {
+	if r.Empty {
+		sb.WriteString("empty")
+		return
+	}
+	if r.From.IsIncluded() {
+		sb.WriteByte('[')
+	} else {
+		sb.WriteByte('(')
+	}
+	if !r.From.IsUnbound() {
+		writeElement(sb, r.From.Value().String())
+	}
+	sb.WriteByte(',')
+	if !r.Till.IsUnbound() {
+		writeElement(sb, r.Till.Value().String())
+	}
+	if r.Till.IsIncluded() {
+		sb.WriteByte(']')
+	} else {
+		sb.WriteByte(')')
+	}
+}
+
+// writeElement writes an element of a range, quoting it like Postgres does: quotes and backslashes are doubled.
+func writeElement(sb *strings.Builder, element string) {
+	if element != "" && !strings.ContainsAny(element, "\"\\,()[]{} \t\n\r\v\f") {
+		sb.WriteString(element)
+		return
+	}
+	sb.WriteByte('"')
+	for _, c := range element {
+		if c == '"' || c == '\\' {
+			sb.WriteRune(c)
+		}
+		sb.WriteRune(c)
+	}
+	sb.WriteByte('"')
+}
+
+// parseRange parses a range, that starts at pos, and returns a position right after it.
+func parseRange[T any](s string, pos int, parse segment.ValueParser[T]) (Range[T], int, error) {
+	var r Range[T]
+	fail := func(pos int, msg string, err error) (Range[T], int, error) {
+		return r, pos, &segment.ParseError{Input: s, Pos: pos, Msg: msg, Err: err}
+	}
+	if len(s)-pos >= len("empty") && strings.EqualFold(s[pos:pos+len("empty")], "empty") {
+		return Range[T]{Empty: true}, pos + len("empty"), nil
+	}
+	if pos == len(s) || (s[pos] != '[' && s[pos] != '(') {
+		return fail(pos, "expected '[', '(' or empty", nil)
+	}
+	fromBound := segment.Excluded
+	if s[pos] == '[' {
+		fromBound = segment.Included
+	}
+	from, pos, err := parseElement(s, pos+1, fromBound, parse)
+	if err != nil {
+		return fail(pos, "invalid lower bound", err)
+	}
+	if pos == len(s) || s[pos] != ',' {
+		return fail(pos, "expected ','", nil)
+	}
+	start := pos + 1
+	end := start
+	// find a closing bracket to know a bound of an upper element before parsing it
+	for quoted := false; end < len(s); end++ {
+		if s[end] == '\\' {
+			end++
+			continue
+		}
+		if s[end] == '"' {
+			quoted = !quoted
+		} else if !quoted && (s[end] == ']' || s[end] == ')') {
+			break
+		}
+	}
+	if end >= len(s) {
+		return fail(len(s), "expected ']' or ')'", nil)
+	}
+	tillBound := segment.Excluded
+	if s[end] == ']' {
+		tillBound = segment.Included
+	}
+	till, pos, err := parseElement(s, start, tillBound, parse)
+	if err != nil {
+		return fail(pos, "invalid upper bound", err)
+	}
+	if pos != end {
+		return fail(pos, "expected ']' or ')'", nil)
+	}
+	r.From, r.Till = from.AsFrom(), till.AsTill()
+	return r, end + 1, nil
+}
+
+// parseElement parses an element, that starts at pos and ends before an unquoted ',', ')' or ']'.
+// A missing element is an unbound border. In case of an error, a position of the element is returned.
+func parseElement[T any](s string, pos int, bound segment.Bound, parse segment.ValueParser[T]) (segment.Border[T], int, error) {
+	start := pos
+	var sb strings.Builder
+	quoted, wasQuoted := false, false
+	for ; pos < len(s); pos++ {
+		c := s[pos]
+		switch {
+		case c == '\\' && pos+1 < len(s):
+			pos++
+			sb.WriteByte(s[pos])
+		case c == '"' && quoted && pos+1 < len(s) && s[pos+1] == '"':
+			pos++
+			sb.WriteByte('"')
+		case c == '"':
+			quoted = !quoted
+			wasQuoted = true
+		case !quoted && (c == ',' || c == ')' || c == ']'):
+			return element(sb.String(), wasQuoted, start, pos, bound, parse)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	if quoted {
+		return segment.Border[T]{}, start, &segment.ParseError{Input: s, Pos: start, Msg: "unterminated quoted element"}
+	}
+	return element(sb.String(), wasQuoted, start, pos, bound, parse)
+}
+
+func element[T any](raw string, quoted bool, start, end int, bound segment.Bound, parse segment.ValueParser[T]) (segment.Border[T], int, error) {
+	if !quoted {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			return segment.NewUnbound[T](), end, nil
+		}
+	}
+	value, err := parse(raw)
+	if errors.Is(err, errInfinity) {
+		return segment.NewUnbound[T](), end, nil
+	}
+	if err != nil {
+		return segment.Border[T]{}, start, err
+	}
+	return segment.NewBorder(bound, value), end, nil
+}
+
+// skipSpaces returns a position of the first rune after pos, that is not a space.
+// Runes are decoded, so a byte of a multibyte rune is never taken for a space.
+func skipSpaces(s string, pos int) int {
+	for pos < len(s) {
+		r, size := utf8.DecodeRuneInString(s[pos:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		pos += size
+	}
+	return pos
+}
@@ -0,0 +1,120 @@
+package pgrange
+
+import (
+	"errors"
+	"github.com/pioniro/segment-go"
+	segment_int "github.com/pioniro/segment-go/integers"
+	"testing"
+)
+
+type textValue string
+
+func (v textValue) String() string                       { return string(v) }
+func (v textValue) Next() (segment.Value[string], error) { return nil, segment.ErrHasNoNextValue }
+func (v textValue) Prev() (segment.Value[string], error) { return nil, segment.ErrHasNoPrevValue }
+func (v textValue) Value() string                        { return string(v) }
+func parseText(s string) (segment.Value[string], error)  { return textValue(s), nil }
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "[1,10)", want: "[1,10)"},
+		{input: "  (1, 10]  ", want: "(1,10]"},
+		{input: "(,5]", want: "(,5]"},
+		{input: "[,5]", want: "(,5]"},
+		{input: "[1,)", want: "[1,)"},
+		{input: "(,)", want: "(,)"},
+		{input: "empty", want: "empty"},
+		{input: " EMPTY ", want: "empty"},
+		{input: "\u00a0[1,\u00a010)\u00a0", want: "[1,10)"},
+		{input: `["1","10")`, want: "[1,10)"},
+		{input: `[-5,"\3")`, want: "[-5,3)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := ParseRange(tt.input, segment_int.ParseInt[int64])
+			if err != nil {
+				t.Fatalf("ParseRange() error = %v", err)
+			}
+			if got := FormatRange(r); got != tt.want {
+				t.Errorf("ParseRange() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRange_Quoted(t *testing.T) {
+	tests := []struct {
+		input    string
+		wantFrom string
+		wantTill string
+		format   string
+	}{
+		{input: `["a b","c,d")`, wantFrom: "a b", wantTill: "c,d", format: `["a b","c,d")`},
+		{input: `["say ""hi""",z]`, wantFrom: `say "hi"`, wantTill: "z", format: `["say ""hi""",z]`},
+		{input: `[a\,b,"\\")`, wantFrom: "a,b", wantTill: `\`, format: `["a,b","\\")`},
+		{input: `("",x)`, wantFrom: "", wantTill: "x", format: `("",x)`},
+		{input: `["(]",")"]`, wantFrom: "(]", wantTill: ")", format: `["(]",")"]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := ParseRange(tt.input, parseText)
+			if err != nil {
+				t.Fatalf("ParseRange() error = %v", err)
+			}
+			if r.From.Value().Value() != tt.wantFrom || r.Till.Value().Value() != tt.wantTill {
+				t.Errorf("ParseRange() = %q %q, want %q %q", r.From.Value().Value(), r.Till.Value().Value(), tt.wantFrom, tt.wantTill)
+			}
+			if got := FormatRange(r); got != tt.format {
+				t.Errorf("FormatRange() = %s, want %s", got, tt.format)
+			}
+			back, err := ParseRange(FormatRange(r), parseText)
+			if err != nil || back.From.Value().Value() != tt.wantFrom || back.Till.Value().Value() != tt.wantTill {
+				t.Errorf("ParseRange(FormatRange()) = %v, %v", back, err)
+			}
+		})
+	}
+}
+
+func TestParseRange_Errors(t *testing.T) {
+	for _, input := range []string{"", "1,2", "[1;2)", "[1,2", "[1,2) x", "[a,2)", `["1,2)`, "emptyx", "[1,2,3)"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseRange(input, segment_int.ParseInt[int64])
+			var perr *segment.ParseError
+			if !errors.As(err, &perr) {
+				t.Errorf("ParseRange() error = %v, want ParseError", err)
+			}
+		})
+	}
+}
+
+func TestParseMultirange(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "{}", want: "{}"},
+		{input: " { } ", want: "{}"},
+		{input: "{[1,3),[5,7)}", want: "{[1,3),[5,7)}"},
+		{input: "{ [1,3) , empty, (,0] }", want: "{[1,3),(,0]}"},
+		{input: "{empty}", want: "{}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ranges, err := ParseMultirange(tt.input, segment_int.ParseInt[int64])
+			if err != nil {
+				t.Fatalf("ParseMultirange() error = %v", err)
+			}
+			if got := FormatMultirange(ranges); got != tt.want {
+				t.Errorf("ParseMultirange() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+	for _, input := range []string{"", "[1,2)", "{[1,2)", "{[1,2);[3,4)}", "{[1,2)} x", "{,}"} {
+		if _, err := ParseMultirange(input, segment_int.ParseInt[int64]); err == nil {
+			t.Errorf("ParseMultirange(%q) error = nil", input)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package pgrange
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/timeseg"
+	"strings"
+	"time"
+)
+
+// errInfinity is returned by a parser of an element, that is infinity or -infinity, such an element is an unbound border.
+var errInfinity = errors.New("pgrange: infinite element")
+
+// microsecond is a precision of Postgres timestamps.
+var microsecond = timeseg.Fixed(time.Microsecond)
+
+// pgTimeLayouts are layouts of timestamps, that Postgres writes: with an offset in hours, minutes or seconds,
+// without an offset for tsrange, and RFC 3339. Fractional seconds are accepted by time.Parse without a layout.
+var pgTimeLayouts = []string{
+	"2006-01-02 15:04:05-07",
+	"2006-01-02 15:04:05-07:00",
+	"2006-01-02 15:04:05-07:00:00",
+	"2006-01-02 15:04:05",
+	time.RFC3339Nano,
+}
+
+// TimeRange is a TimeSegment, that can be read from and written to tstzrange and tsrange columns.
+// Times are scanned with a precision of a microsecond, times of tsrange are scanned in UTC.
+// Elements infinity and -infinity are scanned as unbound borders.
+// A NULL is scanned as a nil Segment, and an empty range as [0001-01-01T00:00:00Z;0001-01-01T00:00:00Z).
+type TimeRange struct {
+	Segment *timeseg.TimeSegment
+}
+
+// Scan implements sql.Scanner.
+func (r *TimeRange) Scan(src any) error {
+	if src == nil {
+		r.Segment = nil
+		return nil
+	}
+	s, err := asString(src)
+	if err != nil {
+		return err
+	}
+	rng, err := ParseRange(s, parsePGTime)
+	if err != nil {
+		return err
+	}
+	if rng.Empty {
+		zero := timeseg.Time(time.Time{}, microsecond)
+		r.Segment = timeseg.NewTimeSegment(segment.NewIncluded(zero), segment.NewExcluded(zero))
+		return nil
+	}
+	r.Segment = timeseg.NewTimeSegment(rng.From, rng.Till)
+	return nil
+}
+
+// Value implements driver.Valuer. Times are written as Postgres writes them: "2024-01-01 10:00:00.5+03".
+func (r *TimeRange) Value() (driver.Value, error) {
+	if r == nil || r.Segment == nil {
+		return nil, nil
+	}
+	if r.Segment.IsEmpty() {
+		return FormatRange(Range[time.Time]{Empty: true}), nil
+	}
+	border := func(b segment.Border[time.Time]) segment.Border[time.Time] {
+		if b.IsUnbound() {
+			return b
+		}
+		bound := segment.Excluded
+		if b.IsIncluded() {
+			bound = segment.Included
+		}
+		return segment.NewBorder(bound, segment.Value[time.Time](pgTime{b.Value()}))
+	}
+	return FormatRange(Range[time.Time]{From: border(*r.Segment.From()), Till: border(*r.Segment.Till())}), nil
+}
+
+// pgTime is a time value, that is formatted as Postgres does, keeping seconds of an offset, that RFC 3339 drops.
+type pgTime struct {
+	v segment.Value[time.Time]
+}
+
+func (t pgTime) String() string {
+	v := t.v.Value()
+	_, offset := v.Zone()
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	zone := fmt.Sprintf("%c%02d", sign, offset/3600)
+	if offset%3600 != 0 {
+		zone += fmt.Sprintf(":%02d", offset%3600/60)
+	}
+	if offset%60 != 0 {
+		zone += fmt.Sprintf(":%02d", offset%60)
+	}
+	return v.Format("2006-01-02 15:04:05.999999999") + zone
+}
+
+func (t pgTime) Next() (segment.Value[time.Time], error) {
+	return t.v.Next()
+}
+
+func (t pgTime) Prev() (segment.Value[time.Time], error) {
+	return t.v.Prev()
+}
+
+func (t pgTime) Value() time.Time {
+	return t.v.Value()
+}
+
+// parsePGTime parses an element of tstzrange or tsrange: 2024-01-01 10:00:00.5+03, infinity.
+func parsePGTime(s string) (segment.Value[time.Time], error) {
+	switch strings.ToLower(s) {
+	case "infinity", "-infinity":
+		return nil, errInfinity
+	}
+	var err error
+	for _, layout := range pgTimeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			return timeseg.Time(t, microsecond), nil
+		}
+	}
+	return nil, err
+}
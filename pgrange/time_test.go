@@ -0,0 +1,92 @@
+package pgrange
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"github.com/pioniro/segment-go/timeseg"
+	"testing"
+	"time"
+)
+
+var (
+	_ sql.Scanner   = (*TimeRange)(nil)
+	_ driver.Valuer = (*TimeRange)(nil)
+)
+
+func TestTimeRange_Scan(t *testing.T) {
+	tests := []struct {
+		src    any
+		want   string
+		offset int
+	}{
+		{src: `["2024-01-01 10:00:00+00","2024-01-02 00:00:00+00")`, want: "[2024-01-01T10:00:00Z;2024-01-02T00:00:00Z)"},
+		{src: []byte(`("2024-01-01 10:00:00.123456+05:30",)`), want: "(2024-01-01T10:00:00.123456+05:30;+inf)"},
+		{src: `["1900-01-01 00:00:00+00:53:28",infinity]`, want: "[1900-01-01T00:00:00+00:53;+inf)", offset: 53*60 + 28},
+		{src: `[-infinity,"2024-01-01 10:00:00.1234567+00"]`, want: "(-inf;2024-01-01T10:00:00.123456Z]"},
+		{src: `["2024-01-01 10:00:00","2024-01-01 11:00:00")`, want: "[2024-01-01T10:00:00Z;2024-01-01T11:00:00Z)"},
+		{src: `[2024-01-01T10:00:00Z,2024-01-01T11:00:00+01:00]`, want: "[2024-01-01T10:00:00Z;2024-01-01T11:00:00+01:00]"},
+	}
+	for _, tt := range tests {
+		var r TimeRange
+		if err := r.Scan(tt.src); err != nil {
+			t.Fatalf("Scan(%s) error = %v", tt.src, err)
+		}
+		if got := r.Segment.String(); got != tt.want {
+			t.Errorf("Scan(%s) = %s, want %s", tt.src, got, tt.want)
+		}
+		// RFC 3339 drops seconds of an offset, but a time keeps them
+		if _, offset := r.Segment.From().Value().Value().Zone(); tt.offset != 0 && offset != tt.offset {
+			t.Errorf("Scan(%s) has an offset %ds, want %ds", tt.src, offset, tt.offset)
+		}
+	}
+	var r TimeRange
+	if err := r.Scan("empty"); err != nil || !r.Segment.IsEmpty() {
+		t.Errorf("Scan(empty) = %v, %v, want an empty segment", r.Segment, err)
+	}
+	if err := r.Scan(nil); err != nil || r.Segment != nil {
+		t.Errorf("Scan(nil) = %v, %v, want nil", r.Segment, err)
+	}
+	for _, src := range []any{`[yesterday,)`, `[2024-01-01 10:00:00+00,`, 42} {
+		if err := r.Scan(src); err == nil {
+			t.Errorf("Scan(%v) error = nil", src)
+		}
+	}
+}
+
+func TestTimeRange_Value(t *testing.T) {
+	tests := []struct {
+		r    *TimeRange
+		want driver.Value
+	}{
+		{r: &TimeRange{Segment: timeseg.MustParse("[2024-01-01T10:00:00Z;2024-01-02T00:00:00+03:00)", timeseg.Second)}, want: `["2024-01-01 10:00:00+00","2024-01-02 00:00:00+03")`},
+		{r: &TimeRange{Segment: timeseg.MustParse("(-inf;2024-01-01T10:00:00.5Z]", timeseg.Millisecond)}, want: `(,"2024-01-01 10:00:00.5+00"]`},
+		{r: &TimeRange{Segment: timeseg.MustParse("(2024-01-01T10:00:00Z;2024-01-01T10:00:01Z)", timeseg.Second)}, want: "empty"},
+		{r: &TimeRange{}, want: nil},
+		{r: nil, want: nil},
+	}
+	for _, tt := range tests {
+		v, err := tt.r.Value()
+		if err != nil || v != tt.want {
+			t.Errorf("Value() = %v, %v, want %v", v, err, tt.want)
+		}
+	}
+	// a value can be scanned back, even with an offset in seconds
+	var src TimeRange
+	if err := src.Scan(`["1900-01-01 00:00:00.25-00:53:28",infinity)`); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	v, err := src.Value()
+	if want := `["1900-01-01 00:00:00.25-00:53:28",)`; err != nil || v != want {
+		t.Errorf("Value() = %v, %v, want %s", v, err, want)
+	}
+	var got TimeRange
+	if err := got.Scan(v); err != nil {
+		t.Fatalf("Scan(Value()) error = %v", err)
+	}
+	if !got.Segment.From().Value().Value().Equal(src.Segment.From().Value().Value()) || !got.Segment.Till().IsUnbound() {
+		t.Errorf("Scan(Value()) = %s, want %s", got.Segment, src.Segment)
+	}
+	if !got.Segment.IsIncludes(time.Date(1900, 1, 1, 0, 53, 28, 250000000, time.UTC)) {
+		t.Errorf("Scan(Value()) does not include its left border")
+	}
+}
@@ -0,0 +1,30 @@
+package runes
+
+import (
+	"github.com/pioniro/segment-go"
+)
+
+// UnmarshalJSON decodes a segment from an object with code points as numbers or from a string in interval notation.
+// rune is int32, so values are not registered with segment.RegisterDefaultValue, integers registers int32 already.
+func (s *RuneSegment) UnmarshalJSON(data []byte) error {
+	from, till, err := segment.UnmarshalSegmentJSON(data, Rune, ParseRune)
+	if err != nil {
+		return err
+	}
+	seg, err := NewRuneSegment(from, till)
+	if err != nil {
+		return err
+	}
+	*s = *seg
+	return nil
+}
+
+// UnmarshalText decodes a segment in interval notation: ['a';'z'], [U+0400;U+04FF].
+func (s *RuneSegment) UnmarshalText(text []byte) error {
+	seg, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*s = *seg
+	return nil
+}
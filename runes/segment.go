@@ -0,0 +1,131 @@
+package runes
+
+import (
+	gen "github.com/pioniro/generator-go"
+	"github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/ordered"
+	"iter"
+	"unicode"
+)
+
+// RuneSegment is an implementation of ISegment, TryToSegment, IncludedSegment, IterableSegment interfaces for code points.
+// Unbound borders mean U+0000 and U+10FFFF: (-inf;'z'] == [U+0000;'z'].
+type RuneSegment struct {
+	*ordered.OrderedSegment[rune]
+}
+
+// NewRuneSegment creates a new segment, it returns ErrInvalidRune if any border is out of [U+0000; U+10FFFF].
+func NewRuneSegment(from, till segment.Border[rune]) (*RuneSegment, error) {
+	for _, b := range []segment.Border[rune]{from, till} {
+		if !b.IsUnbound() && !isValid(b.Value().Value()) {
+			return nil, ErrInvalidRune
+		}
+	}
+	return &RuneSegment{
+		OrderedSegment: ordered.NewOrderedSegment(from, till),
+	}, nil
+}
+
+// MustNewRuneSegment is like NewRuneSegment, but panics if any border is out of range.
+func MustNewRuneSegment(from, till segment.Border[rune]) *RuneSegment {
+	s, err := NewRuneSegment(from, till)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Range returns a segment [lo;hi] of code points, see NewRuneSegment for errors.
+func Range(lo, hi rune) (*RuneSegment, error) {
+	return NewRuneSegment(segment.NewIncluded(Rune(lo)), segment.NewIncluded(Rune(hi)))
+}
+
+// TryTo tries to create a new segment from a given segment, but with different borders if it is possible.
+// If from value is Excluded(U+10FFFF) and we want to cast it to Included, then we return an error ErrHasNoNextValue.
+// If till value is Excluded(U+0000) and we want to cast it to Included, then we return an error ErrHasNoPrevValue.
+func (s *RuneSegment) TryTo(from segment.Bound, till segment.Bound) (segment.TryToSegment[rune], error) {
+	f, err := ordered.LeftBoundTo(*s.From(), from)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := ordered.RightBoundTo(*s.Till(), till)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRuneSegment(f, t)
+}
+
+// IsIncludes returns true if a segment includes a code point. Runes out of [U+0000; U+10FFFF] are never included.
+func (s *RuneSegment) IsIncludes(point rune) bool {
+	return isValid(point) && s.OrderedSegment.IsIncludes(point)
+}
+
+// Size returns a number of code points in a segment: Size( ['a';'z'] ) == 26.
+func (s *RuneSegment) Size() (int, error) {
+	lo, hi, ok := bounds(s.OrderedSegment)
+	if !ok {
+		return 0, nil
+	}
+	return int(hi-lo) + 1, nil
+}
+
+// Iterate returns a generator of all code points of a segment.
+func (s *RuneSegment) Iterate() gen.Generator[rune] {
+	return segment.FromSeq(s.All())
+}
+
+// All returns an iterator over all code points of a segment in ascending order, see Iterate.
+func (s *RuneSegment) All() iter.Seq[rune] {
+	return func(yield func(rune) bool) {
+		lo, hi, ok := bounds(s.OrderedSegment)
+		if !ok {
+			return
+		}
+		for r := lo; r <= hi; r++ {
+			if !yield(r) {
+				return
+			}
+		}
+	}
+}
+
+// Parse parses a rune segment in interval notation, that is produced by String: ['a';'z'], [U+0400;U+04FF], (-inf;U+0020).
+func Parse(s string) (*RuneSegment, error) {
+	from, till, err := segment.ParseBorders(s, ParseRune)
+	if err != nil {
+		return nil, err
+	}
+	return NewRuneSegment(from, till)
+}
+
+// MustParse is like Parse, but panics if a string cannot be parsed.
+func MustParse(s string) *RuneSegment {
+	seg, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return seg
+}
+
+// bounds returns the first and the last code points of a segment, unbound borders are U+0000 and U+10FFFF.
+// If a segment is empty, then false will be returned.
+func bounds(s *ordered.OrderedSegment[rune]) (rune, rune, bool) {
+	inc, err := s.TryTo(segment.Included, segment.Included)
+	if err != nil {
+		return 0, 0, false
+	}
+	lo, hi := rune(0), rune(unicode.MaxRune)
+	if !inc.From().IsUnbound() {
+		lo = max(lo, inc.From().Value().Value())
+	}
+	if !inc.Till().IsUnbound() {
+		hi = min(hi, inc.Till().Value().Value())
+	}
+	return lo, hi, lo <= hi
+}
+
+func isValid(r rune) bool {
+	return r >= 0 && r <= unicode.MaxRune
+}
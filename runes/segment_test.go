@@ -0,0 +1,98 @@
+package runes
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/pioniro/segment-go"
+	"testing"
+	"unicode"
+)
+
+func TestNewRuneSegment(t *testing.T) {
+	if _, err := NewRuneSegment(segment.NewIncluded(Rune(-1)), segment.NewUnbound[rune]()); !errors.Is(err, ErrInvalidRune) {
+		t.Errorf("NewRuneSegment() error = %v, want ErrInvalidRune", err)
+	}
+	if _, err := Range('a', unicode.MaxRune+1); !errors.Is(err, ErrInvalidRune) {
+		t.Errorf("Range() error = %v, want ErrInvalidRune", err)
+	}
+	tests := map[string]string{
+		"['a';'z']":        "['a';'z']",
+		"[U+0400; U+04FF]": "['Ѐ';'ӿ']",
+		"(-inf;U+0020)":    "(-inf;U+0020)",
+		"[ U+003B , 'z' ]": "[U+003B;'z']",
+		"['(';')']":        "[U+0028;U+0029]",
+	}
+	for input, want := range tests {
+		if got := MustParse(input).String(); got != want {
+			t.Errorf("MustParse(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestRuneSegment_Size(t *testing.T) {
+	tests := map[string]int{
+		"['a';'z']":       26,
+		"(-inf;+inf)":     unicode.MaxRune + 1,
+		"(-inf;U+0000)":   0,
+		"(U+10FFFF;+inf)": 0,
+		"(U+10FFFE;+inf)": 1,
+		"('a';'b')":       0,
+	}
+	for input, want := range tests {
+		got, err := MustParse(input).Size()
+		if err != nil || got != want {
+			t.Errorf("Size(%s) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+}
+
+func TestRuneSegment_IsIncludes(t *testing.T) {
+	s := MustParse("(-inf;'z']")
+	tests := map[rune]bool{
+		-1:  false,
+		0:   true,
+		'z': true,
+		'{': false,
+	}
+	for point, want := range tests {
+		if got := s.IsIncludes(point); got != want {
+			t.Errorf("IsIncludes(%d) = %v, want %v", point, got, want)
+		}
+	}
+}
+
+func TestRuneSegment_All(t *testing.T) {
+	var got []rune
+	for r := range MustParse("(U+10FFFC;+inf)").All() {
+		got = append(got, r)
+	}
+	if len(got) != 3 || got[2] != unicode.MaxRune {
+		t.Errorf("All() = %U", got)
+	}
+	var word string
+	MustParse("['a';'e')").Iterate()(func(r rune, err error) bool {
+		word += string(r)
+		return err == nil
+	})
+	if word != "abcd" {
+		t.Errorf("Iterate() = %v", word)
+	}
+}
+
+func TestRuneSegment_JSON(t *testing.T) {
+	s := MustParse("['A';'Z']")
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"from":{"bound":"included","value":65},"till":{"bound":"included","value":90}}`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	for _, input := range []string{want, `"['A';'Z']"`, `"[U+0041;U+005A]"`} {
+		var got RuneSegment
+		if err := json.Unmarshal([]byte(input), &got); err != nil || got.String() != s.String() {
+			t.Errorf("Unmarshal(%s) = %v, %v", input, &got, err)
+		}
+	}
+}
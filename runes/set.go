@@ -0,0 +1,103 @@
+package runes
+
+import (
+	"fmt"
+	"github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/ordered"
+	"strings"
+	"unicode"
+)
+
+// NewSet creates a set of code points from given segments, merging overlapping and adjacent ones.
+// Set operations (Union, Intersect, Difference, Complement) are provided by ordered.SegmentSet.
+func NewSet(segments ...*RuneSegment) *ordered.SegmentSet[rune] {
+	result := make([]*ordered.OrderedSegment[rune], len(segments))
+	for i, seg := range segments {
+		result[i] = seg.OrderedSegment
+	}
+	return ordered.NewSegmentSet(result...)
+}
+
+// FromRangeTable creates a set of all code points of given tables: FromRangeTable(unicode.Latin, unicode.Cyrillic).
+// Ranges with a stride are split into single code points.
+func FromRangeTable(tables ...*unicode.RangeTable) *ordered.SegmentSet[rune] {
+	var segments []*ordered.OrderedSegment[rune]
+	add := func(lo, hi, stride rune) {
+		if stride == 1 {
+			segments = append(segments, ordered.NewOrderedSegment(segment.NewIncluded(Rune(lo)), segment.NewIncluded(Rune(hi))))
+			return
+		}
+		for r := lo; r <= hi; r += stride {
+			segments = append(segments, ordered.NewOrderedSegment(segment.NewIncluded(Rune(r)), segment.NewIncluded(Rune(r))))
+		}
+	}
+	for _, t := range tables {
+		for _, r := range t.R16 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+		for _, r := range t.R32 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+	}
+	return ordered.NewSegmentSet(segments...)
+}
+
+// RangeTable converts a set of code points to a table, that can be used with unicode.Is.
+// Unbound borders mean U+0000 and U+10FFFF.
+func RangeTable(set *ordered.SegmentSet[rune]) *unicode.RangeTable {
+	t := &unicode.RangeTable{}
+	for seg := range set.All() {
+		lo, hi, ok := bounds(seg)
+		if !ok {
+			continue
+		}
+		// a range crossing U+FFFF is split into a 16-bit part and a 32-bit part
+		if lo <= 0xFFFF {
+			r16 := unicode.Range16{Lo: uint16(lo), Hi: uint16(min(hi, 0xFFFF)), Stride: 1}
+			t.R16 = append(t.R16, r16)
+			if r16.Hi <= unicode.MaxLatin1 {
+				t.LatinOffset++
+			}
+			lo = 0x10000
+		}
+		if lo <= hi {
+			t.R32 = append(t.R32, unicode.Range32{Lo: uint32(lo), Hi: uint32(hi), Stride: 1})
+		}
+	}
+	return t
+}
+
+// CharClass returns a regular expression character class, that matches exactly code points of a set:
+// [0-9A-Za-z\x{0400}-\x{04FF}]. ASCII letters and digits are written as is, other code points are escaped.
+// An empty set is [^\x{0000}-\x{10FFFF}], that matches nothing.
+func CharClass(set *ordered.SegmentSet[rune]) string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for seg := range set.All() {
+		lo, hi, ok := bounds(seg)
+		if !ok {
+			continue
+		}
+		sb.WriteString(escape(lo))
+		switch {
+		case hi == lo+1:
+			sb.WriteString(escape(hi))
+		case hi > lo:
+			sb.WriteByte('-')
+			sb.WriteString(escape(hi))
+		}
+	}
+	if sb.Len() == 1 {
+		return `[^\x{0000}-\x{10FFFF}]`
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// escape returns a rune as is if it is an ASCII letter or digit, otherwise it returns \x{XXXX}.
+func escape(r rune) string {
+	if r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+		return string(r)
+	}
+	return fmt.Sprintf(`\x{%04X}`, r)
+}
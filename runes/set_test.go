@@ -0,0 +1,95 @@
+package runes
+
+import (
+	"github.com/pioniro/segment-go/ordered"
+	"regexp"
+	"testing"
+	"unicode"
+)
+
+func set(segments ...string) *ordered.SegmentSet[rune] {
+	result := make([]*RuneSegment, len(segments))
+	for i, s := range segments {
+		result[i] = MustParse(s)
+	}
+	return NewSet(result...)
+}
+
+func TestCharClass(t *testing.T) {
+	tests := []struct {
+		set  *ordered.SegmentSet[rune]
+		want string
+	}{
+		{set: set("['a';'z']", "['A';'Z']", "[U+0400;U+04FF]"), want: `[A-Za-z\x{0400}-\x{04FF}]`},
+		{set: set("['0';'1']", "['_';'_']", "['-';'-']"), want: `[\x{002D}01\x{005F}]`},
+		{set: set("(-inf;+inf)"), want: `[\x{0000}-\x{10FFFF}]`},
+		{set: set(), want: `[^\x{0000}-\x{10FFFF}]`},
+		{set: set("[']';'^']"), want: `[\x{005D}\x{005E}]`},
+	}
+	for _, tt := range tests {
+		if got := CharClass(tt.set); got != tt.want {
+			t.Errorf("CharClass(%v) = %v, want %v", tt.set, got, tt.want)
+		}
+	}
+}
+
+func TestCharClass_Regexp(t *testing.T) {
+	sets := []*ordered.SegmentSet[rune]{
+		set("['a';'z']", "['A';'Z']", "[U+0400;U+04FF]"),
+		set("(-inf;U+0020]", "['[';'^']", "[U+FFFF;U+10000]"),
+		set("(-inf;+inf)").Difference(set("['0';'9']")),
+		set(),
+	}
+	for _, s := range sets {
+		re := regexp.MustCompile("^" + CharClass(s) + "$")
+		table := RangeTable(s)
+		for r := rune(0); r <= unicode.MaxRune; r++ {
+			// surrogates can't be encoded in UTF-8
+			if r >= 0xD800 && r <= 0xDFFF {
+				continue
+			}
+			want := s.Contains(r)
+			if got := re.MatchString(string(r)); got != want {
+				t.Fatalf("%s matches %U = %v, want %v", re, r, got, want)
+			}
+			if got := unicode.Is(table, r); got != want {
+				t.Fatalf("unicode.Is(%v, %U) = %v, want %v", s, r, got, want)
+			}
+		}
+	}
+}
+
+func TestFromRangeTable(t *testing.T) {
+	s := FromRangeTable(unicode.Cyrillic, unicode.Latin)
+	for _, r := range []rune{'a', 'Z', 'ж', 'Ѐ', 0x1E00} {
+		if !s.Contains(r) {
+			t.Errorf("Contains(%U) = false", r)
+		}
+	}
+	for _, r := range []rune{'0', ' ', 'α'} {
+		if s.Contains(r) {
+			t.Errorf("Contains(%U) = true", r)
+		}
+	}
+	// a table with a stride
+	strided := &unicode.RangeTable{R16: []unicode.Range16{{Lo: 'a', Hi: 'e', Stride: 2}}}
+	if got := FromRangeTable(strided).String(); got != "{['a';'a'], ['c';'c'], ['e';'e']}" {
+		t.Errorf("FromRangeTable() = %v", got)
+	}
+}
+
+func TestRangeTable(t *testing.T) {
+	table := RangeTable(set("['a';'z']", "[U+00E0;U+00FF]", "[U+FFF0;U+10010]"))
+	if len(table.R16) != 3 || len(table.R32) != 1 || table.LatinOffset != 2 {
+		t.Errorf("RangeTable() = %+v", table)
+	}
+	// a table is the same as the one, that it is created from
+	for _, want := range []*unicode.RangeTable{unicode.Greek, unicode.Han, unicode.Nd} {
+		got := RangeTable(FromRangeTable(want))
+		for r := rune(0); r <= unicode.MaxRune; r++ {
+			if unicode.Is(got, r) != unicode.Is(want, r) {
+				t.Fatalf("RangeTable() differs at %U", r)
+			}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Package runes provides segments of Unicode code points, conversions to and from unicode.RangeTable
+// and regular expression character classes.
+package runes
+
+import (
+	"errors"
+	"fmt"
+	"github.com/pioniro/segment-go"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidRune = errors.New("runes: code point must be from U+0000 to U+10FFFF")
+
+type runeValue struct {
+	value rune
+}
+
+// Rune returns a value of a code point. Next and Prev stay in [U+0000; U+10FFFF].
+func Rune(r rune) segment.Value[rune] {
+	return &runeValue{
+		value: r,
+	}
+}
+
+func (v *runeValue) Value() rune {
+	return v.value
+}
+
+// String returns a quoted rune if it is graphic and can be safely written in interval notation: 'A', 'я',
+// otherwise a code point in U+ notation: U+0020, U+003B, U+0000.
+func (v *runeValue) String() string {
+	if isPlain(v.value) {
+		return "'" + string(v.value) + "'"
+	}
+	return fmt.Sprintf("U+%04X", v.value)
+}
+
+// Next returns a next code point or ErrHasNoNextValue for U+10FFFF.
+func (v *runeValue) Next() (segment.Value[rune], error) {
+	if v.value >= unicode.MaxRune {
+		return v, segment.ErrHasNoNextValue
+	}
+	return Rune(v.value + 1), nil
+}
+
+// Prev returns a prev code point or ErrHasNoPrevValue for U+0000.
+func (v *runeValue) Prev() (segment.Value[rune], error) {
+	if v.value <= 0 {
+		return v, segment.ErrHasNoPrevValue
+	}
+	return Rune(v.value - 1), nil
+}
+
+// ParseRune parses a code point in U+ notation: U+0041, u+1F600, or a quoted rune: 'A', '\n', '\u0400'.
+func ParseRune(s string) (segment.Value[rune], error) {
+	var r rune
+	switch {
+	case strings.HasPrefix(s, "U+") || strings.HasPrefix(s, "u+"):
+		n, err := strconv.ParseUint(s[2:], 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		if n > unicode.MaxRune {
+			return nil, ErrInvalidRune
+		}
+		r = rune(n)
+	case strings.HasPrefix(s, "'"):
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, err
+		}
+		runes := []rune(unquoted)
+		if len(runes) != 1 {
+			return nil, &strconv.NumError{Func: "ParseRune", Num: s, Err: strconv.ErrSyntax}
+		}
+		r = runes[0]
+	default:
+		return nil, &strconv.NumError{Func: "ParseRune", Num: s, Err: strconv.ErrSyntax}
+	}
+	return Rune(r), nil
+}
+
+// isPlain returns true if a rune can be written as is: it is visible and it is not a part of interval notation or quoting.
+func isPlain(r rune) bool {
+	return unicode.IsGraphic(r) && !unicode.IsSpace(r) && !strings.ContainsRune(`;,()[]"'\`, r)
+}
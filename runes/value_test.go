@@ -0,0 +1,67 @@
+package runes
+
+import (
+	"errors"
+	"github.com/pioniro/segment-go"
+	"testing"
+	"unicode"
+)
+
+func TestRune_String(t *testing.T) {
+	tests := map[rune]string{
+		'A':      "'A'",
+		'я':      "'я'",
+		'😀':      "'😀'",
+		' ':      "U+0020",
+		';':      "U+003B",
+		'\'':     "U+0027",
+		'\\':     "U+005C",
+		'(':      "U+0028",
+		']':      "U+005D",
+		'"':      "U+0022",
+		0:        "U+0000",
+		0xD800:   "U+D800",
+		0x10FFFF: "U+10FFFF",
+	}
+	for r, want := range tests {
+		if got := Rune(r).String(); got != want {
+			t.Errorf("String(%d) = %v, want %v", r, got, want)
+		}
+		v, err := ParseRune(want)
+		if err != nil || v.Value() != r {
+			t.Errorf("ParseRune(%q) = %v, %v, want %d", want, v, err, r)
+		}
+	}
+}
+
+func TestParseRune(t *testing.T) {
+	tests := map[string]rune{
+		"u+41": 'A',
+		`'\n'`: '\n',
+		`'Ѐ'`:  0x400,
+	}
+	for input, want := range tests {
+		v, err := ParseRune(input)
+		if err != nil || v.Value() != want {
+			t.Errorf("ParseRune(%q) = %v, %v, want %d", input, v, err, want)
+		}
+	}
+	for _, input := range []string{"U+110000", "'ab'", "A", "U+", "''"} {
+		if _, err := ParseRune(input); err == nil {
+			t.Errorf("ParseRune(%q) error = nil", input)
+		}
+	}
+}
+
+func TestRune_NextPrev(t *testing.T) {
+	if _, err := Rune(unicode.MaxRune).Next(); !errors.Is(err, segment.ErrHasNoNextValue) {
+		t.Errorf("Next() error = %v, want ErrHasNoNextValue", err)
+	}
+	if _, err := Rune(0).Prev(); !errors.Is(err, segment.ErrHasNoPrevValue) {
+		t.Errorf("Prev() error = %v, want ErrHasNoPrevValue", err)
+	}
+	next, err := Rune('z').Next()
+	if err != nil || next.Value() != '{' {
+		t.Errorf("Next() = %v, %v", next, err)
+	}
+}
@@ -77,7 +77,7 @@ func (s *Segment[T]) Till() *Border[T] {
 
 func NewSegment[T any](from, till Border[T]) *Segment[T] {
 	return &Segment[T]{
-		F: from,
-		T: till,
+		F: from.AsFrom(),
+		T: till.AsTill(),
 	}
 }
@@ -0,0 +1,127 @@
+package timeseg
+
+import (
+	gen "github.com/pioniro/generator-go"
+	"iter"
+	"time"
+)
+
+// Period is a calendar period, that a segment can be split by: a day, a week, a month, a quarter or a year.
+// Periods are calculated in a location of a time, so they respect DST transitions.
+type Period interface {
+	// Start returns a beginning of a period, that includes t.
+	Start(t time.Time) time.Time
+	// Next returns a beginning of a next period after a beginning of a period.
+	Next(start time.Time) time.Time
+}
+
+type dailyPeriod struct{}
+
+type weeklyPeriod struct {
+	start time.Weekday
+}
+
+type monthlyPeriod struct {
+	months int
+	// fiscal is a first month of a fiscal year
+	fiscal time.Month
+}
+
+// Daily returns a period of a calendar day: 23 or 25 hours long if DST changes.
+func Daily() Period {
+	return dailyPeriod{}
+}
+
+// Weekly returns a period of a week, that starts on a given weekday. Use time.Monday for ISO 8601 weeks.
+func Weekly(start time.Weekday) Period {
+	return weeklyPeriod{start: start}
+}
+
+// Monthly returns a period of a calendar month.
+func Monthly() Period {
+	return monthlyPeriod{months: 1, fiscal: time.January}
+}
+
+// Quarterly returns a period of a quarter of a fiscal year, that starts on the first day of a given month:
+// Quarterly(time.April) has quarters Apr-Jun, Jul-Sep, Oct-Dec and Jan-Mar. Zero month is treated as January.
+func Quarterly(fiscalStart time.Month) Period {
+	return monthlyPeriod{months: 3, fiscal: fiscalStart}
+}
+
+// Yearly returns a period of a fiscal year, that starts on the first day of a given month:
+// Yearly(time.October) has years Oct-Sep. Zero month is treated as January.
+func Yearly(fiscalStart time.Month) Period {
+	return monthlyPeriod{months: 12, fiscal: fiscalStart}
+}
+
+func (p dailyPeriod) Start(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return startOfDay(y, m, d, t.Location())
+}
+
+func (p dailyPeriod) Next(start time.Time) time.Time {
+	y, m, d := start.Date()
+	return startOfDay(y, m, d+1, start.Location())
+}
+
+func (p weeklyPeriod) Start(t time.Time) time.Time {
+	y, m, d := t.Date()
+	shift := (int(t.Weekday()) - int(p.start) + 7) % 7
+	return startOfDay(y, m, d-shift, t.Location())
+}
+
+func (p weeklyPeriod) Next(start time.Time) time.Time {
+	y, m, d := start.Date()
+	return startOfDay(y, m, d+7, start.Location())
+}
+
+func (p monthlyPeriod) Start(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	fiscal := p.fiscal
+	if fiscal == 0 {
+		fiscal = time.January
+	}
+	// months since a beginning of a fiscal year, and since a beginning of a period
+	sinceYear := (int(m) - int(fiscal) + 12) % 12
+	return startOfDay(y, m-time.Month(sinceYear%p.months), 1, t.Location())
+}
+
+func (p monthlyPeriod) Next(start time.Time) time.Time {
+	y, m, _ := start.Date()
+	return startOfDay(y, m+time.Month(p.months), 1, start.Location())
+}
+
+// startOfDay returns the first instant of a calendar date in a location, out of range days and months are normalized.
+// It is midnight, unless DST starts at midnight: then midnight does not exist, time.Date moves it to 23:00
+// of the previous day, and the day starts at the transition instead: 2024-03-10 in America/Havana starts at 01:00.
+func startOfDay(y int, m time.Month, d int, loc *time.Location) time.Time {
+	t := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	// a normalized date, that is not affected by DST
+	want := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	if gy, gm, gd := t.Date(); gy == want.Year() && gm == want.Month() && gd == want.Day() {
+		return t
+	}
+	_, end := t.ZoneBounds()
+	return end
+}
+
+// SplitCalendar splits a segment into pieces aligned to calendar periods in a location of its left border:
+//
+//	[2024-01-15;2024-04-03) by Monthly() -> [01-15;02-01), [02-01;03-01), [03-01;04-01), [04-01;04-03)
+//
+// The first and the last pieces can be shorter than a period. Borders of pieces have a precision of a segment.
+// If from is unbound, then empty gen will be returned. If till is unbound, then gen is infinite.
+func (s *TimeSegment) SplitCalendar(period Period) gen.Generator[*TimeSegment] {
+	return func(yield gen.Yield[*TimeSegment]) {
+		for chunk := range s.CalendarChunks(period) {
+			if !yield(chunk, nil) {
+				return
+			}
+		}
+	}
+}
+
+// CalendarChunks returns an iterator over pieces of a segment aligned to calendar periods, see SplitCalendar.
+func (s *TimeSegment) CalendarChunks(period Period) iter.Seq[*TimeSegment] {
+	return s.chunks(func(l time.Time) time.Time { return period.Next(period.Start(l)) })
+}
@@ -0,0 +1,185 @@
+package timeseg
+
+import (
+	"github.com/pioniro/segment-go"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func dates(t *testing.T, s *TimeSegment, period Period) []string {
+	t.Helper()
+	var result []string
+	for _, chunk := range s.SplitCalendar(period).Collect() {
+		result = append(result, chunk.From().Value().Value().Format("2006-01-02")+"/"+chunk.Till().Value().Value().Format("2006-01-02"))
+	}
+	return result
+}
+
+func daySegment(from, till time.Time, loc *time.Location) *TimeSegment {
+	return NewTimeSegment(segment.NewIncluded(Time(from, Day(loc))), segment.NewExcluded(Time(till, Day(loc))))
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestTimeSegment_SplitCalendar(t *testing.T) {
+	s := daySegment(date(2024, 1, 15), date(2024, 4, 3), time.UTC)
+	tests := []struct {
+		name   string
+		period Period
+		want   []string
+	}{
+		{name: "monthly", period: Monthly(), want: []string{
+			"2024-01-15/2024-02-01", "2024-02-01/2024-03-01", "2024-03-01/2024-04-01", "2024-04-01/2024-04-03",
+		}},
+		{name: "quarterly", period: Quarterly(time.January), want: []string{
+			"2024-01-15/2024-04-01", "2024-04-01/2024-04-03",
+		}},
+		{name: "fiscal quarterly", period: Quarterly(time.February), want: []string{
+			"2024-01-15/2024-02-01", "2024-02-01/2024-04-03",
+		}},
+		{name: "yearly", period: Yearly(0), want: []string{"2024-01-15/2024-04-03"}},
+		{name: "fiscal yearly", period: Yearly(time.April), want: []string{
+			"2024-01-15/2024-04-01", "2024-04-01/2024-04-03",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dates(t, s, tt.period); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitCalendar() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeSegment_SplitCalendar_Weeks(t *testing.T) {
+	// 2024-01-03 is Wednesday
+	s := daySegment(date(2024, 1, 3), date(2024, 1, 18), time.UTC)
+	iso := []string{"2024-01-03/2024-01-08", "2024-01-08/2024-01-15", "2024-01-15/2024-01-18"}
+	if got := dates(t, s, Weekly(time.Monday)); !reflect.DeepEqual(got, iso) {
+		t.Errorf("SplitCalendar(Monday) = %v, want %v", got, iso)
+	}
+	sunday := []string{"2024-01-03/2024-01-07", "2024-01-07/2024-01-14", "2024-01-14/2024-01-18"}
+	if got := dates(t, s, Weekly(time.Sunday)); !reflect.DeepEqual(got, sunday) {
+		t.Errorf("SplitCalendar(Sunday) = %v, want %v", got, sunday)
+	}
+	wednesday := []string{"2024-01-03/2024-01-10", "2024-01-10/2024-01-17", "2024-01-17/2024-01-18"}
+	if got := dates(t, s, Weekly(time.Wednesday)); !reflect.DeepEqual(got, wednesday) {
+		t.Errorf("SplitCalendar(Wednesday) = %v, want %v", got, wednesday)
+	}
+}
+
+func TestTimeSegment_SplitCalendar_Location(t *testing.T) {
+	loc := mustLoad(t, "America/New_York")
+	s := NewTimeSegment(
+		segment.NewIncluded(Time(time.Date(2024, 3, 9, 12, 0, 0, 0, loc), Second)),
+		segment.NewExcluded(Time(time.Date(2024, 3, 11, 12, 0, 0, 0, loc), Second)),
+	)
+	var got []string
+	for chunk := range s.CalendarChunks(Daily()) {
+		size, _ := chunk.Size()
+		got = append(got, chunk.From().Value().String()+" "+size.String())
+	}
+	want := []string{
+		"2024-03-09T12:00:00-05:00 12h0m0s",
+		"2024-03-10T00:00:00-05:00 23h0m0s",
+		"2024-03-11T00:00:00-04:00 12h0m0s",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CalendarChunks() = %v, want %v", got, want)
+	}
+
+	// month borders are in a location of a segment, not in UTC
+	months := daySegment(time.Date(2024, 1, 15, 0, 0, 0, 0, loc), time.Date(2024, 3, 1, 0, 0, 0, 0, loc), loc)
+	var borders []string
+	for chunk := range months.CalendarChunks(Monthly()) {
+		borders = append(borders, chunk.Till().Value().String())
+	}
+	if want := []string{"2024-02-01T00:00:00-05:00", "2024-03-01T00:00:00-05:00"}; !reflect.DeepEqual(borders, want) {
+		t.Errorf("CalendarChunks() = %v, want %v", borders, want)
+	}
+}
+
+func TestTimeSegment_SplitCalendar_MidnightDST(t *testing.T) {
+	// DST starts at 00:00 in Havana, so 2024-03-10 starts at 01:00 and is 23 hours long
+	loc := mustLoad(t, "America/Havana")
+	s := NewTimeSegment(
+		segment.NewIncluded(Time(time.Date(2024, 3, 9, 12, 0, 0, 0, loc), Second)),
+		segment.NewExcluded(Time(time.Date(2024, 3, 11, 12, 0, 0, 0, loc), Second)),
+	)
+	tests := []struct {
+		period Period
+		want   []string
+	}{
+		{period: Daily(), want: []string{
+			"2024-03-09T12:00:00-05:00 12h0m0s",
+			"2024-03-10T01:00:00-04:00 23h0m0s",
+			"2024-03-11T00:00:00-04:00 12h0m0s",
+		}},
+		{period: Weekly(time.Sunday), want: []string{
+			"2024-03-09T12:00:00-05:00 12h0m0s",
+			"2024-03-10T01:00:00-04:00 35h0m0s",
+		}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for chunk := range s.CalendarChunks(tt.period) {
+			size, _ := chunk.Size()
+			got = append(got, chunk.From().Value().String()+" "+size.String())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("CalendarChunks(%v) = %v, want %v", tt.period, got, tt.want)
+		}
+	}
+	if got, want := Daily().Start(time.Date(2024, 3, 10, 15, 0, 0, 0, loc)), time.Date(2024, 3, 10, 1, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("Start() = %s, want %s", got, want)
+	}
+	if got, want := Daily().Next(time.Date(2024, 3, 9, 0, 0, 0, 0, loc)), time.Date(2024, 3, 10, 1, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("Next() = %s, want %s", got, want)
+	}
+}
+
+func TestTimeSegment_SplitCalendar_Edges(t *testing.T) {
+	unbound := NewTimeSegment(segment.NewIncluded(Time(date(2024, 11, 20), Day(nil))), segment.NewUnbound[time.Time]())
+	var got []string
+	for chunk := range unbound.CalendarChunks(Monthly()) {
+		got = append(got, chunk.String())
+		if len(got) == 3 {
+			break
+		}
+	}
+	want := []string{
+		"[2024-11-20T00:00:00Z;2024-12-01T00:00:00Z)",
+		"[2024-12-01T00:00:00Z;2025-01-01T00:00:00Z)",
+		"[2025-01-01T00:00:00Z;2025-02-01T00:00:00Z)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CalendarChunks() of unbound segment = %v, want %v", got, want)
+	}
+	left := NewTimeSegment(segment.NewUnbound[time.Time](), segment.NewIncluded(Time(date(2024, 1, 1), Day(nil))))
+	if n := len(left.SplitCalendar(Daily()).Collect()); n != 0 {
+		t.Errorf("len(SplitCalendar()) of left unbound segment = %d, want 0", n)
+	}
+	// a precision coarser than a period: borders are truncated to 90 days since the zero time,
+	// [2024-01-01;2024-06-01] -> [2023-10-18;2024-04-15], and each piece is one step of a precision
+	months := NewTimeSegment(segment.NewIncluded(Time(date(2024, 1, 1), Fixed(90*24*time.Hour))), segment.NewIncluded(Time(date(2024, 6, 1), Fixed(90*24*time.Hour))))
+	got = got[:0]
+	for _, chunk := range months.SplitCalendar(Daily()).Collect() {
+		got = append(got, chunk.String())
+	}
+	want = []string{
+		"[2023-10-18T00:00:00Z;2024-01-16T00:00:00Z)",
+		"[2024-01-16T00:00:00Z;2024-04-15T00:00:00Z)",
+		"[2024-04-15T00:00:00Z;2024-07-14T00:00:00Z)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SplitCalendar() with coarse precision = %v, want %v", got, want)
+	}
+	// an inclusive right border
+	inclusive := NewTimeSegment(segment.NewIncluded(Time(date(2024, 1, 30), Day(nil))), segment.NewIncluded(Time(date(2024, 2, 1), Day(nil))))
+	if got, want := dates(t, inclusive, Monthly()), []string{"2024-01-30/2024-02-01", "2024-02-01/2024-02-02"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SplitCalendar() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,39 @@
+package timeseg
+
+import (
+	"github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/internal/runs"
+	"iter"
+)
+
+// SplitBy groups consecutive days of a segment into maximal runs with the same key and returns an iterator over keys and runs.
+// Runs have included borders: [2024-01-15;2024-03-10] by month -> January: [2024-01-15;2024-01-31],
+// February: [2024-02-01;2024-02-29], March: [2024-03-01;2024-03-10].
+//
+// Days are not iterated one by one, the end of a run is found with an exponential and a binary search,
+// so it requires, that days with the same key are contiguous, as with months, weeks or quarters.
+// If a segment is unbound, then the iterator is empty: there is no way to know, whether a key of the last run ever changes,
+// so bound a segment first, as for Size.
+func SplitBy[K comparable](s *DateSegment, key func(Date) K) iter.Seq2[K, *DateSegment] {
+	return func(yield func(K, *DateSegment) bool) {
+		if s.from.IsUnbound() || s.till.IsUnbound() || s.IsEmpty() {
+			return
+		}
+		from, till := s.canonical()
+		start := from.Value().Value().days()
+		span := till.Value().Value().days() - start
+		at := func(offset int64) Date {
+			return dateFromDays(start + offset)
+		}
+		for offset := int64(0); ; {
+			k := key(at(offset))
+			end := runs.End(offset, span, func(o int64) bool {
+				return key(at(o)) == k
+			})
+			if !yield(k, NewDateSegment(segment.NewIncluded[Date](at(offset)), segment.NewIncluded[Date](at(end)))) || end == span {
+				return
+			}
+			offset = end + 1
+		}
+	}
+}
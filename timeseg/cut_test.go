@@ -0,0 +1,75 @@
+package timeseg
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSplitBy(t *testing.T) {
+	month := func(d Date) time.Month { return d.Month }
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{input: "[2024-01-15;2024-03-10]", want: []string{"January [2024-01-15;2024-01-31]", "February [2024-02-01;2024-02-29]", "March [2024-03-01;2024-03-10]"}},
+		{input: "(2023-12-31;2024-02-01)", want: []string{"January [2024-01-01;2024-01-31]"}},
+		{input: "[2024-02-29;2024-02-29]", want: []string{"February [2024-02-29;2024-02-29]"}},
+		{input: "[2024-02-29;2024-02-28]", want: nil},
+		{input: "(-inf;2024-02-28]", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var got []string
+			for k, run := range SplitBy(mustParseDateSegment(t, tt.input), month) {
+				got = append(got, k.String()+" "+run.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitBy_Long(t *testing.T) {
+	s := mustParseDateSegment(t, "[2024-11-20;+10000-01-01)")
+	calls := 0
+	year := func(d Date) int {
+		calls++
+		return min(d.Year, 2026)
+	}
+	var got []string
+	for _, run := range SplitBy(s, year) {
+		got = append(got, run.String())
+	}
+	want := []string{"[2024-11-20;2024-12-31]", "[2025-01-01;2025-12-31]", "[2026-01-01;9999-12-31]"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SplitBy() = %v, want %v", got, want)
+	}
+	if calls > 100 {
+		t.Errorf("SplitBy() calls key %d times", calls)
+	}
+	got = got[:0]
+	for _, run := range SplitBy(s, func(d Date) int { return d.Year }) {
+		got = append(got, run.String())
+		if len(got) == 2 {
+			break
+		}
+	}
+	if want := []string{"[2024-11-20;2024-12-31]", "[2025-01-01;2025-12-31]"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SplitBy() with break = %v, want %v", got, want)
+	}
+	unbound := mustParseDateSegment(t, "[2024-11-20;+inf)")
+	for k, run := range SplitBy(unbound, year) {
+		t.Errorf("SplitBy() of unbound segment = %v: %s, want nothing", k, run)
+	}
+}
+
+func mustParseDateSegment(t *testing.T, s string) *DateSegment {
+	t.Helper()
+	seg, err := ParseDateSegment(s)
+	if err != nil {
+		t.Fatalf("ParseDateSegment(%q) error = %v", s, err)
+	}
+	return seg
+}
@@ -0,0 +1,198 @@
+package timeseg
+
+import (
+	"fmt"
+	"github.com/pioniro/segment-go"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Date is a civil date without a time and a time zone in the proleptic Gregorian calendar.
+// It implements segment.Value itself: Next and Prev move it by one day.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns a date, normalizing out of range months and days like time.Date does: 2024-02-30 -> 2024-03-01
+func NewDate(year int, month time.Month, day int) Date {
+	// months since January of year 0, floor division is used for negative months
+	months := int64(year)*12 + int64(month) - 1
+	y, m := floorDiv(months, 12), months-floorDiv(months, 12)*12+1
+	return dateFromDays(daysFromCivil(y, m, 1) + int64(day) - 1)
+}
+
+// DateOf returns a date of a time in its location, use t.In(loc) to get a date in other location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// ParseDate parses a date in ISO 8601 format: 2024-01-15, or with an expanded year: +12024-01-15, -0044-03-15.
+func ParseDate(s string) (Date, error) {
+	fail := func() (Date, error) {
+		return Date{}, fmt.Errorf("timeseg: cannot parse %q as a date: expected YYYY-MM-DD", s)
+	}
+	sign, rest := 1, s
+	if rest != "" && (rest[0] == '+' || rest[0] == '-') {
+		if rest[0] == '-' {
+			sign = -1
+		}
+		rest = rest[1:]
+	}
+	parts := strings.Split(rest, "-")
+	if len(parts) != 3 || len(parts[0]) < 4 || len(parts[1]) != 2 || len(parts[2]) != 2 {
+		return fail()
+	}
+	// years with more than 4 digits must have a sign
+	if len(parts[0]) > 4 && len(rest) == len(s) {
+		return fail()
+	}
+	var nums [3]int
+	for i, part := range parts {
+		for _, c := range part {
+			if c < '0' || c > '9' {
+				return fail()
+			}
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return fail()
+		}
+		nums[i] = n
+	}
+	d := Date{Year: sign * nums[0], Month: time.Month(nums[1]), Day: nums[2]}
+	if !d.IsValid() {
+		return Date{}, fmt.Errorf("timeseg: %q is not a valid date", s)
+	}
+	return d, nil
+}
+
+// IsValid returns true if a date exists in a calendar: 2024-02-29 is valid, 2023-02-29 is not.
+func (d Date) IsValid() bool {
+	return NewDate(d.Year, d.Month, d.Day) == d
+}
+
+// In returns a beginning of a date in a given location.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns a date n days after d, n can be negative.
+func (d Date) AddDays(n int) Date {
+	return dateFromDays(d.days() + int64(n))
+}
+
+// Sub returns a number of days from o to d: 2024-03-01 - 2024-02-01 = 29.
+func (d Date) Sub(o Date) int {
+	return int(d.days() - o.days())
+}
+
+// Compare returns -1 if d is before o, 1 if d is after o and 0 if they are equal.
+func (d Date) Compare(o Date) int {
+	return compareInt64(d.days(), o.days())
+}
+
+// Weekday returns a day of a week of a date.
+func (d Date) Weekday() time.Weekday {
+	// 1970-01-01 is Thursday
+	w := (d.days() + int64(time.Thursday)) % 7
+	if w < 0 {
+		w += 7
+	}
+	return time.Weekday(w)
+}
+
+// String returns a date in ISO 8601 format: 2024-01-15. Years out of [0;9999] have a sign: -0044-03-15, +12024-01-15.
+func (d Date) String() string {
+	year := fmt.Sprintf("%04d", d.Year)
+	switch {
+	case d.Year < 0:
+		year = fmt.Sprintf("-%04d", -d.Year)
+	case d.Year > 9999:
+		year = "+" + year
+	}
+	return fmt.Sprintf("%s-%02d-%02d", year, int(d.Month), d.Day)
+}
+
+func (d Date) Next() (segment.Value[Date], error) {
+	return d.AddDays(1), nil
+}
+
+func (d Date) Prev() (segment.Value[Date], error) {
+	return d.AddDays(-1), nil
+}
+
+func (d Date) Value() Date {
+	return d
+}
+
+// MarshalText encodes a date in ISO 8601 format, so it is a string in JSON: "2024-01-15".
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Date) UnmarshalText(text []byte) error {
+	date, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
+}
+
+// days returns a number of days since 1970-01-01.
+func (d Date) days() int64 {
+	return daysFromCivil(int64(d.Year), int64(d.Month), 1) + int64(d.Day) - 1
+}
+
+// daysFromCivil returns a number of days since 1970-01-01 of a valid date in the proleptic Gregorian calendar.
+// See http://howardhinnant.github.io/date_algorithms.html
+func daysFromCivil(y, m, d int64) int64 {
+	if m <= 2 {
+		y--
+	}
+	era := floorDiv(y, 400)
+	yoe := y - era*400
+	mp := (m + 9) % 12
+	doy := (153*mp+2)/5 + d - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+	return era*146097 + doe - 719468
+}
+
+// dateFromDays is an inverse of daysFromCivil.
+func dateFromDays(z int64) Date {
+	z += 719468
+	era := floorDiv(z, 146097)
+	doe := z - era*146097
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+	y := yoe + era*400
+	doy := doe - (365*yoe + yoe/4 - yoe/100)
+	mp := (5*doy + 2) / 153
+	d := doy - (153*mp+2)/5 + 1
+	m := (mp+2)%12 + 1
+	if m <= 2 {
+		y++
+	}
+	return Date{Year: int(y), Month: time.Month(m), Day: int(d)}
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
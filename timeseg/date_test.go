@@ -0,0 +1,118 @@
+package timeseg
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestNewDate(t *testing.T) {
+	tests := []struct {
+		got  Date
+		want string
+	}{
+		{got: NewDate(2024, 2, 29), want: "2024-02-29"},
+		{got: NewDate(2023, 2, 29), want: "2023-03-01"},
+		{got: NewDate(2024, 13, 1), want: "2025-01-01"},
+		{got: NewDate(2024, 0, 1), want: "2023-12-01"},
+		{got: NewDate(2024, 1, 0), want: "2023-12-31"},
+		{got: NewDate(2024, -11, 1), want: "2023-01-01"},
+		{got: NewDate(1900, 2, 29), want: "1900-03-01"},
+		{got: NewDate(2000, 2, 29), want: "2000-02-29"},
+		{got: NewDate(0, 2, 29), want: "0000-02-29"},
+		{got: NewDate(-1, 12, 31).AddDays(1), want: "0000-01-01"},
+		{got: NewDate(-44, 3, 15), want: "-0044-03-15"},
+		{got: NewDate(12024, 1, 15), want: "+12024-01-15"},
+	}
+	for _, tt := range tests {
+		if tt.got.String() != tt.want {
+			t.Errorf("NewDate() = %s, want %s", tt.got, tt.want)
+		}
+	}
+}
+
+func TestDate_MatchesTime(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	epoch := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 10000; i++ {
+		// about ±2700 years around 1970
+		n := rnd.Intn(2_000_000) - 1_000_000
+		tm := epoch.AddDate(0, 0, n)
+		d := DateOf(tm)
+		if got := NewDate(1970, 1, 1).AddDays(n); got != d {
+			t.Fatalf("AddDays(%d) = %s, want %s", n, got, d)
+		}
+		if got := d.Sub(NewDate(1970, 1, 1)); got != n {
+			t.Fatalf("Sub() of %s = %d, want %d", d, got, n)
+		}
+		if d.Weekday() != tm.Weekday() {
+			t.Fatalf("Weekday() of %s = %s, want %s", d, d.Weekday(), tm.Weekday())
+		}
+		if !d.In(time.UTC).Equal(tm) {
+			t.Fatalf("In() of %s = %s, want %s", d, d.In(time.UTC), tm)
+		}
+	}
+}
+
+func TestDate_NextPrev(t *testing.T) {
+	d := NewDate(2024, 2, 28)
+	next, _ := d.Next()
+	next2, _ := next.Next()
+	if next.String() != "2024-02-29" || next2.String() != "2024-03-01" {
+		t.Errorf("Next() = %s, %s", next, next2)
+	}
+	prev, _ := NewDate(2024, 1, 1).Prev()
+	if prev.Value() != NewDate(2023, 12, 31) {
+		t.Errorf("Prev() = %s, want 2023-12-31", prev)
+	}
+}
+
+func TestDateOf(t *testing.T) {
+	loc := mustLoad(t, "Asia/Tokyo")
+	tm := time.Date(2024, 1, 15, 20, 0, 0, 0, time.UTC)
+	if got := DateOf(tm); got != NewDate(2024, 1, 15) {
+		t.Errorf("DateOf() = %s, want 2024-01-15", got)
+	}
+	if got := DateOf(tm.In(loc)); got != NewDate(2024, 1, 16) {
+		t.Errorf("DateOf() in Tokyo = %s, want 2024-01-16", got)
+	}
+	if got := NewDate(2024, 1, 16).In(loc); !got.Equal(time.Date(2024, 1, 15, 15, 0, 0, 0, time.UTC)) {
+		t.Errorf("In() = %s", got)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	valid := map[string]Date{
+		"2024-01-15":   NewDate(2024, 1, 15),
+		"2024-02-29":   NewDate(2024, 2, 29),
+		"0001-01-01":   NewDate(1, 1, 1),
+		"-0044-03-15":  NewDate(-44, 3, 15),
+		"+12024-01-15": NewDate(12024, 1, 15),
+		"+2024-01-15":  NewDate(2024, 1, 15),
+	}
+	for input, want := range valid {
+		got, err := ParseDate(input)
+		if err != nil || got != want {
+			t.Errorf("ParseDate(%s) = %s, %v, want %s", input, got, err, want)
+		}
+	}
+	for _, input := range []string{"", "2024-1-15", "2024-01-15T00:00:00Z", "2023-02-29", "2024-13-01", "2024-00-10", "12024-01-15", "24-01-15", "2024/01/15", "2024-01-+5"} {
+		if _, err := ParseDate(input); err == nil {
+			t.Errorf("ParseDate(%q) error = nil", input)
+		}
+	}
+}
+
+func TestDate_JSON(t *testing.T) {
+	var v struct {
+		Due Date `json:"due"`
+	}
+	if err := json.Unmarshal([]byte(`{"due":"2024-02-29"}`), &v); err != nil || v.Due != NewDate(2024, 2, 29) {
+		t.Fatalf("Unmarshal() = %s, %v", v.Due, err)
+	}
+	data, err := json.Marshal(v)
+	if err != nil || string(data) != `{"due":"2024-02-29"}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+}
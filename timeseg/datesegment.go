@@ -0,0 +1,125 @@
+package timeseg
+
+import (
+	gen "github.com/pioniro/generator-go"
+	"github.com/pioniro/segment-go"
+	"iter"
+)
+
+func init() {
+	segment.RegisterDefaultValue(func(d Date) segment.Value[Date] { return d }, parseDateValue)
+}
+
+// DateSegment is an implementation of ISegment, TryToSegment, IncludedSegment, IterableSegment interfaces for civil dates.
+// Dates are discrete: [2024-01-01;2024-01-31) == [2024-01-01;2024-01-30].
+type DateSegment struct {
+	from segment.Border[Date]
+	till segment.Border[Date]
+}
+
+func NewDateSegment(from, till segment.Border[Date]) *DateSegment {
+	return &DateSegment{
+		from: from.AsFrom(),
+		till: till.AsTill(),
+	}
+}
+
+func (s *DateSegment) From() *segment.Border[Date] {
+	return &s.from
+}
+
+func (s *DateSegment) Till() *segment.Border[Date] {
+	return &s.till
+}
+
+func (s *DateSegment) TryTo(from segment.Bound, till segment.Bound) (segment.TryToSegment[Date], error) {
+	f, err := segment.LeftBoundTo(s.from, from)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := segment.RightBoundTo(s.till, till)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDateSegment(f, t), nil
+}
+
+// String returns a string representation of a segment with dates in ISO 8601 format.
+// example: [2024-01-01;2024-02-01), (-inf;2024-12-31]
+func (s *DateSegment) String() string {
+	return segment.Format[Date](s)
+}
+
+func (s *DateSegment) IsEmpty() bool {
+	from, till := s.canonical()
+	return segment.IsEmptyBorders(from, till, compareDate)
+}
+
+func (s *DateSegment) IsIncludes(point Date) bool {
+	return segment.IncludesFrom(s.from, point, compareDate) && segment.IncludesTill(s.till, point, compareDate)
+}
+
+// Size returns a number of days in a segment: Size( [2024-02-01;2024-03-01) ) == 29.
+// If a segment is unbound, then ErrSegmentTooBig will be returned.
+func (s *DateSegment) Size() (int, error) {
+	if s.IsEmpty() {
+		return 0, nil
+	}
+	if s.from.IsUnbound() || s.till.IsUnbound() {
+		return 0, segment.ErrSegmentTooBig
+	}
+	from, till := s.canonical()
+	return till.Value().Value().Sub(from.Value().Value()) + 1, nil
+}
+
+// Iterate returns a generator of all days of a segment.
+// If from is unbound, then empty gen will be returned. If till is unbound, then gen is infinite.
+func (s *DateSegment) Iterate() gen.Generator[Date] {
+	return segment.FromSeq(s.All())
+}
+
+// All returns an iterator over all days of a segment in ascending order, see Iterate.
+func (s *DateSegment) All() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		if s.from.IsUnbound() || s.IsEmpty() {
+			return
+		}
+		from, till := s.canonical()
+		for d := from.Value().Value(); till.IsUnbound() || d.Compare(till.Value().Value()) <= 0; d = d.AddDays(1) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// ParseDateSegment parses a date segment in interval notation, that is produced by String: [2024-01-01;2024-02-01).
+func ParseDateSegment(s string) (*DateSegment, error) {
+	from, till, err := segment.ParseBorders(s, parseDateValue)
+	if err != nil {
+		return nil, err
+	}
+	return NewDateSegment(from, till), nil
+}
+
+// canonical returns borders of a segment converted to included ones: (2024-01-01;2024-01-31) -> [2024-01-02;2024-01-30]
+// Dates always have next and prev values, so the conversion never fails.
+func (s *DateSegment) canonical() (segment.Border[Date], segment.Border[Date]) {
+	from, _ := segment.LeftBoundTo(s.from, segment.Included)
+	till, _ := segment.RightBoundTo(s.till, segment.Included)
+	return from, till
+}
+
+func parseDateValue(s string) (segment.Value[Date], error) {
+	d, err := ParseDate(s)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func compareDate(a, b Date) int {
+	return a.Compare(b)
+}
@@ -0,0 +1,110 @@
+package timeseg
+
+import (
+	"encoding/json"
+	"github.com/pioniro/segment-go"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestDateSegment_Size(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int
+		wantErr error
+	}{
+		{input: "[2024-02-01;2024-03-01)", want: 29},
+		{input: "[2023-02-01;2023-03-01)", want: 28},
+		{input: "[2024-01-01;2024-12-31]", want: 366},
+		{input: "(2024-01-01;2024-01-02)", want: 0},
+		{input: "[2024-01-01;2024-01-01]", want: 1},
+		{input: "[2024-01-02;2024-01-01]", want: 0},
+		{input: "[1600-01-01;2000-01-01)", want: 146097},
+		{input: "(-inf;2024-01-01]", wantErr: segment.ErrSegmentTooBig},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			s, err := ParseDateSegment(tt.input)
+			if err != nil {
+				t.Fatalf("ParseDateSegment() error = %v", err)
+			}
+			got, err := s.Size()
+			if err != tt.wantErr || got != tt.want {
+				t.Errorf("Size() = %d, %v, want %d, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDateSegment_Iterate(t *testing.T) {
+	s, _ := ParseDateSegment("(2024-02-27;2024-03-02)")
+	want := []Date{NewDate(2024, 2, 28), NewDate(2024, 2, 29), NewDate(2024, 3, 1)}
+	if got := s.Iterate().Collect(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Iterate() = %v, want %v", got, want)
+	}
+	if got := slices.Collect(s.All()); !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+	unbound, _ := ParseDateSegment("[2024-12-30;+inf)")
+	var got []string
+	for d := range unbound.All() {
+		got = append(got, d.String())
+		if len(got) == 3 {
+			break
+		}
+	}
+	if want := []string{"2024-12-30", "2024-12-31", "2025-01-01"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() of unbound segment = %v, want %v", got, want)
+	}
+	left, _ := ParseDateSegment("(-inf;2024-01-01]")
+	if got := slices.Collect(left.All()); got != nil {
+		t.Errorf("All() of left unbound segment = %v, want nil", got)
+	}
+}
+
+func TestDateSegment_IsIncludes(t *testing.T) {
+	s, _ := ParseDateSegment("[2024-01-01;2024-02-01)")
+	tests := map[Date]bool{
+		NewDate(2023, 12, 31): false,
+		NewDate(2024, 1, 1):   true,
+		NewDate(2024, 1, 31):  true,
+		NewDate(2024, 2, 1):   false,
+	}
+	for d, want := range tests {
+		if got := s.IsIncludes(d); got != want {
+			t.Errorf("IsIncludes(%s) = %v, want %v", d, got, want)
+		}
+	}
+	if !NewDateSegment(segment.NewExcluded(NewDate(2024, 1, 1)), segment.NewExcluded(NewDate(2024, 1, 2))).IsEmpty() {
+		t.Errorf("IsEmpty() of (2024-01-01;2024-01-02) = false")
+	}
+	inc, err := s.TryTo(segment.Included, segment.Included)
+	if err != nil || inc.(*DateSegment).String() != "[2024-01-01;2024-01-31]" {
+		t.Errorf("TryTo() = %v, %v, want [2024-01-01;2024-01-31]", inc, err)
+	}
+}
+
+func TestDateSegment_JSON(t *testing.T) {
+	from, till, err := segment.UnmarshalSegmentJSONRegistered[Date]([]byte(`{"from":{"bound":"included","value":"2024-01-01"},"till":{"bound":"unbound"}}`))
+	if err != nil {
+		t.Fatalf("UnmarshalSegmentJSON() error = %v", err)
+	}
+	s := NewDateSegment(from, till)
+	if s.String() != "[2024-01-01;+inf)" {
+		t.Errorf("UnmarshalSegmentJSON() = %s", s)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `{"from":{"bound":"included","value":"2024-01-01"},"till":{"bound":"unbound"}}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+	var v struct {
+		Period *DateSegment `json:"period"`
+	}
+	if err := json.Unmarshal([]byte(`{"period":"[2024-02-01;2024-03-01)"}`), &v); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if size, _ := v.Period.Size(); size != 29 {
+		t.Errorf("Unmarshal() = %s, Size() = %d, want 29", v.Period, size)
+	}
+}
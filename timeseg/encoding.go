@@ -0,0 +1,72 @@
+package timeseg
+
+import (
+	"github.com/pioniro/segment-go"
+	"time"
+)
+
+func init() {
+	segment.RegisterDefaultValue(func(t time.Time) segment.Value[time.Time] { return Time(t, Nanosecond) }, ParseTime(Nanosecond))
+}
+
+// MarshalJSON encodes a segment as an object with times in RFC 3339 format, see segment.MarshalSegmentJSON.
+func (s *TimeSegment) MarshalJSON() ([]byte, error) {
+	return segment.MarshalSegmentJSON[time.Time](s)
+}
+
+// UnmarshalJSON decodes a segment from an object or from a string in interval notation.
+// Times keep a precision of a segment if it is already set, otherwise Nanosecond is used.
+func (s *TimeSegment) UnmarshalJSON(data []byte) error {
+	p := s.Precision()
+	from, till, err := segment.UnmarshalSegmentJSON(data, func(t time.Time) segment.Value[time.Time] { return Time(t, p) }, ParseTime(p))
+	if err != nil {
+		return err
+	}
+	*s = *NewTimeSegment(from, till)
+	return nil
+}
+
+// MarshalText encodes a segment in interval notation, see String.
+func (s *TimeSegment) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a segment in interval notation, see UnmarshalJSON for a precision of times.
+func (s *TimeSegment) UnmarshalText(text []byte) error {
+	seg, err := Parse(string(text), s.Precision())
+	if err != nil {
+		return err
+	}
+	*s = *seg
+	return nil
+}
+
+// MarshalJSON encodes a segment as an object with dates in ISO 8601 format, see segment.MarshalSegmentJSON.
+func (s *DateSegment) MarshalJSON() ([]byte, error) {
+	return segment.MarshalSegmentJSON[Date](s)
+}
+
+// UnmarshalJSON decodes a segment from an object or from a string in interval notation.
+func (s *DateSegment) UnmarshalJSON(data []byte) error {
+	from, till, err := segment.UnmarshalSegmentJSONRegistered[Date](data)
+	if err != nil {
+		return err
+	}
+	*s = *NewDateSegment(from, till)
+	return nil
+}
+
+// MarshalText encodes a segment in interval notation, see String.
+func (s *DateSegment) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a segment in interval notation: [2024-01-01;2024-02-01).
+func (s *DateSegment) UnmarshalText(text []byte) error {
+	seg, err := ParseDateSegment(string(text))
+	if err != nil {
+		return err
+	}
+	*s = *seg
+	return nil
+}
@@ -0,0 +1,47 @@
+package timeseg
+
+import (
+	"github.com/pioniro/segment-go"
+	"time"
+)
+
+// Parse parses a time segment in interval notation with times in RFC 3339 format, that is produced by String:
+// [2024-01-01T00:00:00Z;2024-01-02T00:00:00Z), (-inf;2024-01-01T10:30:00+03:00]. Times are truncated to a precision.
+func Parse(s string, p Precision) (*TimeSegment, error) {
+	from, till, err := segment.ParseBorders(s, ParseTime(p))
+	if err != nil {
+		return nil, err
+	}
+	return NewTimeSegment(from, till), nil
+}
+
+// MustParse is like Parse, but panics if a string cannot be parsed.
+func MustParse(s string, p Precision) *TimeSegment {
+	seg, err := Parse(s, p)
+	if err != nil {
+		panic(err)
+	}
+	return seg
+}
+
+// ParseTime returns a parser of times in RFC 3339 format with a given precision.
+func ParseTime(p Precision) segment.ValueParser[time.Time] {
+	return func(s string) (segment.Value[time.Time], error) {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, err
+		}
+		return Time(t, p), nil
+	}
+}
+
+// ParseDuration returns a parser of durations in the format of time.ParseDuration with a given precision: 1h30m.
+func ParseDuration(precision time.Duration) segment.ValueParser[time.Duration] {
+	return func(s string) (segment.Value[time.Duration], error) {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, err
+		}
+		return Duration(d, precision), nil
+	}
+}
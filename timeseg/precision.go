@@ -0,0 +1,82 @@
+// Package timeseg provides segments of time.Time and values of time.Duration with a configurable precision.
+package timeseg
+
+import "time"
+
+// Precision defines a granularity of time values: Next and Prev of a value move it by one step of a precision.
+type Precision interface {
+	// Truncate rounds a time down to a step of a precision.
+	Truncate(t time.Time) time.Time
+	// Next returns a beginning of a next step after a truncated time.
+	Next(t time.Time) time.Time
+	// Prev returns a beginning of a previous step before a truncated time.
+	Prev(t time.Time) time.Time
+}
+
+var (
+	Nanosecond  = Fixed(time.Nanosecond)
+	Millisecond = Fixed(time.Millisecond)
+	Second      = Fixed(time.Second)
+)
+
+type fixedPrecision struct {
+	step time.Duration
+}
+
+// Fixed returns a precision with a fixed step, for example Fixed(time.Minute).
+// Times are truncated since the zero time, see time.Time.Truncate. A step less than 1ns is treated as 1ns.
+func Fixed(step time.Duration) Precision {
+	return fixedPrecision{step: max(step, time.Nanosecond)}
+}
+
+func (p fixedPrecision) Truncate(t time.Time) time.Time {
+	// Truncate(1ns) doesn't strip a monotonic clock reading, but Round(0) does
+	return t.Truncate(p.step).Round(0)
+}
+
+func (p fixedPrecision) Next(t time.Time) time.Time {
+	return p.Truncate(t).Add(p.step)
+}
+
+func (p fixedPrecision) Prev(t time.Time) time.Time {
+	return p.Truncate(t).Add(-p.step)
+}
+
+func (p fixedPrecision) String() string {
+	return p.step.String()
+}
+
+type dayPrecision struct {
+	loc *time.Location
+}
+
+// Day returns a precision of a calendar day in a given location. Days can be 23 or 25 hours long because of DST.
+// A nil location is treated as UTC.
+func Day(loc *time.Location) Precision {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return dayPrecision{loc: loc}
+}
+
+func (p dayPrecision) Truncate(t time.Time) time.Time {
+	return p.day(t, 0)
+}
+
+func (p dayPrecision) Next(t time.Time) time.Time {
+	return p.day(t, 1)
+}
+
+func (p dayPrecision) Prev(t time.Time) time.Time {
+	return p.day(t, -1)
+}
+
+func (p dayPrecision) String() string {
+	return "day(" + p.loc.String() + ")"
+}
+
+// day returns a beginning of a day, that is shift days after a day of t, see startOfDay.
+func (p dayPrecision) day(t time.Time, shift int) time.Time {
+	y, m, d := t.In(p.loc).Date()
+	return startOfDay(y, m, d+shift, p.loc)
+}
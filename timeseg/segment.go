@@ -0,0 +1,223 @@
+package timeseg
+
+import (
+	gen "github.com/pioniro/generator-go"
+	"github.com/pioniro/segment-go"
+	"github.com/pioniro/segment-go/ordered"
+	"iter"
+	"math"
+	"time"
+)
+
+// TimeSegment is an implementation of ISegment, TryToSegment, IncludedSegment interfaces for time.Time values.
+// Borders are discrete at a precision of their values, see Time: [10:00:00;10:00:05) == [10:00:00;10:00:04] for Second.
+type TimeSegment struct {
+	from segment.Border[time.Time]
+	till segment.Border[time.Time]
+}
+
+func NewTimeSegment(from, till segment.Border[time.Time]) *TimeSegment {
+	return &TimeSegment{
+		from: from.AsFrom(),
+		till: till.AsTill(),
+	}
+}
+
+// NewDurationSegment creates a segment of durations, use Duration to create its values.
+func NewDurationSegment(from, till segment.Border[time.Duration]) *ordered.OrderedSegment[time.Duration] {
+	return ordered.NewOrderedSegment(from, till)
+}
+
+func (s *TimeSegment) From() *segment.Border[time.Time] {
+	return &s.from
+}
+
+func (s *TimeSegment) Till() *segment.Border[time.Time] {
+	return &s.till
+}
+
+// Precision returns a precision of values of a segment, or Nanosecond if both borders are unbound.
+func (s *TimeSegment) Precision() Precision {
+	if !s.from.IsUnbound() {
+		return precisionOf(s.from.Value())
+	}
+	if !s.till.IsUnbound() {
+		return precisionOf(s.till.Value())
+	}
+	return Nanosecond
+}
+
+// TryTo tries to create a new segment from a given segment, but with different borders.
+// Values are moved by one step of their precision: (10:00:00;11:00:00] -> [10:00:01;11:00:00] for Second.
+func (s *TimeSegment) TryTo(from segment.Bound, till segment.Bound) (segment.TryToSegment[time.Time], error) {
+	f, err := segment.LeftBoundTo(s.from, from)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := segment.RightBoundTo(s.till, till)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTimeSegment(f, t), nil
+}
+
+// String returns a string representation of a segment with times in RFC 3339 format.
+// example: [2024-01-01T00:00:00Z;2024-01-02T00:00:00Z), (-inf;2024-01-01T10:30:00.5+03:00]
+func (s *TimeSegment) String() string {
+	return segment.Format[time.Time](s)
+}
+
+func (s *TimeSegment) IsEmpty() bool {
+	if s.from.IsUnbound() && s.till.IsUnbound() {
+		return false
+	}
+	inc, err := s.TryTo(segment.Included, segment.Included)
+	if err != nil {
+		return segment.IsEmptyBorders(s.from, s.till, compareTime)
+	}
+	if inc.From().IsUnbound() || inc.Till().IsUnbound() {
+		return false
+	}
+	return inc.From().Value().Value().After(inc.Till().Value().Value())
+}
+
+// IsIncludes returns true if a segment includes a point. A point is truncated to a precision of a segment,
+// so [10:00:00;10:00:05) and [10:00:00;10:00:04] both include 10:00:04.5 for Second, and (10:00:00;10:00:02) does not include 10:00:00.5.
+func (s *TimeSegment) IsIncludes(point time.Time) bool {
+	if s.IsEmpty() {
+		return false
+	}
+	point = s.Precision().Truncate(point)
+	inc, err := s.TryTo(segment.Included, segment.Included)
+	if err != nil {
+		return segment.IncludesFrom(s.from, point, compareTime) && segment.IncludesTill(s.till, point, compareTime)
+	}
+	return segment.IncludesFrom(*inc.From(), point, compareTime) && segment.IncludesTill(*inc.Till(), point, compareTime)
+}
+
+// Size returns a duration of a segment, bounds are taken into account with a precision of values:
+// Size( [10:00:00;10:00:05] ) == 6s for Second, Size( [Mon;Mon] ) == 24h for Day (or 23h/25h if DST changes).
+// An empty segment has zero size. If a segment is unbound or its duration does not fit into time.Duration,
+// then ErrSegmentTooBig will be returned.
+func (s *TimeSegment) Size() (time.Duration, error) {
+	if s.IsEmpty() {
+		return 0, nil
+	}
+	from, till, ok := s.bounds()
+	if !ok {
+		return 0, segment.ErrSegmentTooBig
+	}
+	// Sub saturates on overflow
+	d := till.Sub(from)
+	if d == math.MaxInt64 {
+		return 0, segment.ErrSegmentTooBig
+	}
+	return d, nil
+}
+
+// Split splits a segment into chunks of a given duration: [A; B) -> [A; A+d), [A+d; A+2d), ... [A+d*N; B).
+// Borders of chunks are truncated to a precision, but a chunk is never shorter than one step of it,
+// so Split(24h) of a Day segment yields calendar days even if DST changes.
+// If d is less than 1ns or from is unbound, then empty gen will be returned. If till is unbound, then gen is infinite.
+func (s *TimeSegment) Split(d time.Duration) gen.Generator[*TimeSegment] {
+	return func(yield gen.Yield[*TimeSegment]) {
+		for chunk := range s.Chunks(d) {
+			if !yield(chunk, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Chunks returns an iterator over chunks of a segment, see Split.
+func (s *TimeSegment) Chunks(d time.Duration) iter.Seq[*TimeSegment] {
+	if d <= 0 {
+		return func(yield func(*TimeSegment) bool) {}
+	}
+	return s.chunks(func(l time.Time) time.Time { return l.Add(d) })
+}
+
+// SplitAligned splits a segment into chunks, whose borders are multiples of a given duration since the zero time,
+// as time.Truncate does: [10:07; 10:35) by 10m -> [10:07; 10:10), [10:10; 10:20), [10:20; 10:30), [10:30; 10:35).
+// Borders of chunks are truncated to a precision as in Split. See SplitAlignedTo for other origins.
+func (s *TimeSegment) SplitAligned(d time.Duration) gen.Generator[*TimeSegment] {
+	return s.SplitAlignedTo(d, time.Time{})
+}
+
+// SplitAlignedTo is like SplitAligned, but borders of chunks are origin + k*d: by 10m to 10:05 -> [10:07; 10:15), [10:15; 10:25), ...
+func (s *TimeSegment) SplitAlignedTo(d time.Duration, origin time.Time) gen.Generator[*TimeSegment] {
+	return func(yield gen.Yield[*TimeSegment]) {
+		for chunk := range s.ChunksAlignedTo(d, origin) {
+			if !yield(chunk, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ChunksAligned returns an iterator over chunks of a segment aligned to multiples of a given duration, see SplitAligned.
+func (s *TimeSegment) ChunksAligned(d time.Duration) iter.Seq[*TimeSegment] {
+	return s.ChunksAlignedTo(d, time.Time{})
+}
+
+// ChunksAlignedTo returns an iterator over chunks of a segment aligned to origin + k*d, see SplitAlignedTo.
+func (s *TimeSegment) ChunksAlignedTo(d time.Duration, origin time.Time) iter.Seq[*TimeSegment] {
+	if d <= 0 {
+		return func(yield func(*TimeSegment) bool) {}
+	}
+	// Truncate works with any distance from the zero time, so an origin is applied as a shift, that is less than d
+	shift := origin.Sub(origin.Truncate(d))
+	return s.chunks(func(l time.Time) time.Time {
+		return l.Add(-shift).Truncate(d).Add(shift + d)
+	})
+}
+
+// chunks returns an iterator over chunks of a segment, next returns a right border of a chunk starting at l.
+func (s *TimeSegment) chunks(next func(l time.Time) time.Time) iter.Seq[*TimeSegment] {
+	return func(yield func(*TimeSegment) bool) {
+		if s.from.IsUnbound() || s.IsEmpty() {
+			return
+		}
+		p := s.Precision()
+		inc, err := s.TryTo(segment.Included, segment.Excluded)
+		if err != nil {
+			return
+		}
+		l := inc.From().Value().Value()
+		unbound := inc.Till().IsUnbound()
+		var end time.Time
+		if !unbound {
+			end = inc.Till().Value().Value()
+		}
+		for unbound || l.Before(end) {
+			r := p.Truncate(next(l))
+			// a precision can be coarser than a step, but a chunk is never shorter than one step of a precision
+			if !r.After(l) {
+				r = p.Next(l)
+			}
+			if !unbound && r.After(end) {
+				r = end
+			}
+			if !yield(NewTimeSegment(segment.NewIncluded(Time(l, p)), segment.NewExcluded(Time(r, p)))) {
+				return
+			}
+			l = r
+		}
+	}
+}
+
+// bounds returns the first time of a segment and the first time after it: [from; till).
+// If a segment is unbound, then false will be returned.
+func (s *TimeSegment) bounds() (time.Time, time.Time, bool) {
+	inc, err := s.TryTo(segment.Included, segment.Excluded)
+	if err != nil || inc.From().IsUnbound() || inc.Till().IsUnbound() {
+		return time.Time{}, time.Time{}, false
+	}
+	return inc.From().Value().Value(), inc.Till().Value().Value(), true
+}
+
+func compareTime(a, b time.Time) int {
+	return a.Compare(b)
+}
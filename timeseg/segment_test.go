@@ -0,0 +1,273 @@
+package timeseg
+
+import (
+	"encoding/json"
+	"github.com/pioniro/segment-go"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func at(hour, min, sec int) time.Time {
+	return time.Date(2024, 1, 1, hour, min, sec, 0, time.UTC)
+}
+
+func TestTimeSegment_IsIncludes(t *testing.T) {
+	s := NewTimeSegment(segment.NewIncluded(Time(at(10, 0, 0), Second)), segment.NewExcluded(Time(at(10, 0, 1), Second)))
+	tests := map[time.Time]bool{
+		at(9, 59, 59):                            false,
+		at(10, 0, 0):                             true,
+		at(10, 0, 0).Add(500 * time.Millisecond): true,
+		at(10, 0, 1):                             false,
+	}
+	for point, want := range tests {
+		if got := s.IsIncludes(point); got != want {
+			t.Errorf("IsIncludes(%s) = %v, want %v", point, got, want)
+		}
+	}
+	open := NewTimeSegment(segment.NewExcluded(Time(at(10, 0, 0), Second)), segment.NewExcluded(Time(at(10, 0, 1), Second)))
+	if !open.IsEmpty() || open.IsIncludes(at(10, 0, 0).Add(time.Millisecond)) {
+		t.Errorf("(10:00:00;10:00:01) of seconds is not empty")
+	}
+	unbound := NewTimeSegment(segment.NewUnbound[time.Time](), segment.NewIncluded(Time(at(10, 0, 0), Second)))
+	if !unbound.IsIncludes(time.Time{}) || unbound.IsIncludes(at(10, 0, 1)) {
+		t.Errorf("IsIncludes() of %s is wrong", unbound)
+	}
+}
+
+func TestTimeSegment_IsIncludes_Canonical(t *testing.T) {
+	sec := func(hour, min, sec int) segment.Value[time.Time] { return Time(at(hour, min, sec), Second) }
+	tests := []struct {
+		a, b  *TimeSegment
+		point time.Time
+		want  bool
+	}{
+		{
+			a:     NewTimeSegment(segment.NewIncluded(sec(10, 0, 0)), segment.NewExcluded(sec(10, 0, 5))),
+			b:     NewTimeSegment(segment.NewIncluded(sec(10, 0, 0)), segment.NewIncluded(sec(10, 0, 4))),
+			point: at(10, 0, 4).Add(500 * time.Millisecond),
+			want:  true,
+		},
+		{
+			a:     NewTimeSegment(segment.NewExcluded(sec(10, 0, 0)), segment.NewExcluded(sec(10, 0, 2))),
+			b:     NewTimeSegment(segment.NewIncluded(sec(10, 0, 1)), segment.NewIncluded(sec(10, 0, 1))),
+			point: at(10, 0, 0).Add(500 * time.Millisecond),
+			want:  false,
+		},
+		{
+			a:     NewTimeSegment(segment.NewExcluded(sec(10, 0, 0)), segment.NewExcluded(sec(10, 0, 2))),
+			b:     NewTimeSegment(segment.NewIncluded(sec(10, 0, 1)), segment.NewIncluded(sec(10, 0, 1))),
+			point: at(10, 0, 1).Add(999 * time.Millisecond),
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a.String(), func(t *testing.T) {
+			if got := tt.a.IsIncludes(tt.point); got != tt.want {
+				t.Errorf("%s.IsIncludes(%s) = %v, want %v", tt.a, tt.point, got, tt.want)
+			}
+			if got := tt.b.IsIncludes(tt.point); got != tt.want {
+				t.Errorf("%s.IsIncludes(%s) = %v, want %v", tt.b, tt.point, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeSegment_Size(t *testing.T) {
+	tests := []struct {
+		s       *TimeSegment
+		want    time.Duration
+		wantErr error
+	}{
+		{s: NewTimeSegment(segment.NewIncluded(Time(at(10, 0, 0), Second)), segment.NewIncluded(Time(at(10, 0, 5), Second))), want: 6 * time.Second},
+		{s: NewTimeSegment(segment.NewExcluded(Time(at(10, 0, 0), Second)), segment.NewExcluded(Time(at(10, 0, 5), Second))), want: 4 * time.Second},
+		{s: NewTimeSegment(segment.NewIncluded(Time(at(10, 0, 0), Nanosecond)), segment.NewExcluded(Time(at(10, 0, 5), Nanosecond))), want: 5 * time.Second},
+		{s: NewTimeSegment(segment.NewIncluded(Time(at(10, 0, 0), Day(nil))), segment.NewIncluded(Time(at(10, 0, 0), Day(nil)))), want: 24 * time.Hour},
+		{s: NewTimeSegment(segment.NewIncluded(Time(at(10, 0, 5), Second)), segment.NewIncluded(Time(at(10, 0, 0), Second))), want: 0},
+		{s: NewTimeSegment(segment.NewUnbound[time.Time](), segment.NewIncluded(Time(at(10, 0, 0), Second))), wantErr: segment.ErrSegmentTooBig},
+		{s: NewTimeSegment(segment.NewIncluded(Time(time.Time{}, Second)), segment.NewIncluded(Time(at(10, 0, 0), Second))), wantErr: segment.ErrSegmentTooBig},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s.String(), func(t *testing.T) {
+			got, err := tt.s.Size()
+			if err != tt.wantErr || got != tt.want {
+				t.Errorf("Size() = %v, %v, want %v, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTimeSegment_Size_DST(t *testing.T) {
+	loc := mustLoad(t, "Europe/Berlin")
+	// DST ends on 2024-10-27, the day is 25 hours long
+	day := Time(time.Date(2024, 10, 27, 12, 0, 0, 0, loc), Day(loc))
+	s := NewTimeSegment(segment.NewIncluded(day), segment.NewIncluded(day))
+	if got, err := s.Size(); err != nil || got != 25*time.Hour {
+		t.Errorf("Size() = %v, %v, want 25h", got, err)
+	}
+	// DST starts at 00:00 in Havana, so 2024-03-10 starts at 01:00 and is 23 hours long
+	havana := mustLoad(t, "America/Havana")
+	day = Time(time.Date(2024, 3, 10, 12, 0, 0, 0, havana), Day(havana))
+	if want := time.Date(2024, 3, 10, 1, 0, 0, 0, havana); !day.Value().Equal(want) {
+		t.Errorf("Time() = %s, want %s", day, want)
+	}
+	s = NewTimeSegment(segment.NewIncluded(day), segment.NewIncluded(day))
+	if got, err := s.Size(); err != nil || got != 23*time.Hour {
+		t.Errorf("Size() = %v, %v, want 23h", got, err)
+	}
+}
+
+func TestTimeSegment_TryTo(t *testing.T) {
+	s := NewTimeSegment(segment.NewExcluded(Time(at(10, 0, 0), Second)), segment.NewExcluded(Time(at(11, 0, 0), Second)))
+	got, err := s.TryTo(segment.Included, segment.Included)
+	if err != nil {
+		t.Fatalf("TryTo() error = %v", err)
+	}
+	if want := "[2024-01-01T10:00:01Z;2024-01-01T10:59:59Z]"; got.(*TimeSegment).String() != want {
+		t.Errorf("TryTo() = %s, want %s", got.(*TimeSegment).String(), want)
+	}
+}
+
+func TestTimeSegment_Split(t *testing.T) {
+	s := NewTimeSegment(segment.NewIncluded(Time(at(10, 0, 0), Second)), segment.NewIncluded(Time(at(10, 0, 9), Second)))
+	var got []string
+	for _, chunk := range s.Split(4 * time.Second).Collect() {
+		got = append(got, chunk.String())
+	}
+	want := []string{
+		"[2024-01-01T10:00:00Z;2024-01-01T10:00:04Z)",
+		"[2024-01-01T10:00:04Z;2024-01-01T10:00:08Z)",
+		"[2024-01-01T10:00:08Z;2024-01-01T10:00:10Z)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() = %v, want %v", got, want)
+	}
+	// a step is never shorter than a precision
+	if n := len(s.Split(time.Millisecond).Collect()); n != 10 {
+		t.Errorf("len(Split(1ms)) = %d, want 10", n)
+	}
+	if n := len(s.Split(0).Collect()); n != 0 {
+		t.Errorf("len(Split(0)) = %d, want 0", n)
+	}
+	unbound := NewTimeSegment(segment.NewIncluded(Time(at(10, 0, 0), Second)), segment.NewUnbound[time.Time]())
+	got = got[:0]
+	for chunk := range unbound.Chunks(time.Hour) {
+		got = append(got, chunk.String())
+		if len(got) == 2 {
+			break
+		}
+	}
+	want = []string{
+		"[2024-01-01T10:00:00Z;2024-01-01T11:00:00Z)",
+		"[2024-01-01T11:00:00Z;2024-01-01T12:00:00Z)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Chunks() of unbound segment = %v, want %v", got, want)
+	}
+	left := NewTimeSegment(segment.NewUnbound[time.Time](), segment.NewIncluded(Time(at(10, 0, 0), Second)))
+	if n := len(left.Split(time.Hour).Collect()); n != 0 {
+		t.Errorf("len(Split()) of left unbound segment = %d, want 0", n)
+	}
+}
+
+func TestTimeSegment_Split_Days(t *testing.T) {
+	loc := mustLoad(t, "America/New_York")
+	p := Day(loc)
+	s := NewTimeSegment(
+		segment.NewIncluded(Time(time.Date(2024, 3, 9, 0, 0, 0, 0, loc), p)),
+		segment.NewIncluded(Time(time.Date(2024, 3, 11, 0, 0, 0, 0, loc), p)),
+	)
+	var got []string
+	for chunk := range s.Chunks(24 * time.Hour) {
+		size, _ := chunk.Size()
+		got = append(got, chunk.From().Value().String()+" "+size.String())
+	}
+	want := []string{
+		"2024-03-09T00:00:00-05:00 24h0m0s",
+		"2024-03-10T00:00:00-05:00 23h0m0s",
+		"2024-03-11T00:00:00-04:00 24h0m0s",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Chunks() = %v, want %v", got, want)
+	}
+}
+
+func TestTimeSegment_SplitAligned(t *testing.T) {
+	s := NewTimeSegment(segment.NewIncluded(Time(at(10, 7, 0), Second)), segment.NewExcluded(Time(at(10, 35, 0), Second)))
+	tests := []struct {
+		name   string
+		origin time.Time
+		want   []string
+	}{
+		{name: "zero", want: []string{"10:07:00-10:10:00", "10:10:00-10:20:00", "10:20:00-10:30:00", "10:30:00-10:35:00"}},
+		{name: "10:05", origin: at(10, 5, 0), want: []string{"10:07:00-10:15:00", "10:15:00-10:25:00", "10:25:00-10:35:00"}},
+		{name: "far", origin: time.Date(1, 1, 1, 0, 0, 3, 0, time.UTC), want: []string{"10:07:00-10:10:03", "10:10:03-10:20:03", "10:20:03-10:30:03", "10:30:03-10:35:00"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for chunk := range s.ChunksAlignedTo(10*time.Minute, tt.origin) {
+				got = append(got, chunk.From().Value().Value().Format(time.TimeOnly)+"-"+chunk.Till().Value().Value().Format(time.TimeOnly))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChunksAlignedTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	// borders are still truncated to a precision
+	if n := len(s.SplitAligned(time.Millisecond).Collect()); n != 28*60 {
+		t.Errorf("len(SplitAligned(1ms)) = %d, want %d", n, 28*60)
+	}
+	var n int
+	for range s.ChunksAligned(0) {
+		n++
+	}
+	if n != 0 {
+		t.Errorf("len(ChunksAligned(0)) = %d, want 0", n)
+	}
+}
+
+func TestParse(t *testing.T) {
+	s, err := Parse("[2024-01-01T10:00:00.75Z; 2024-01-01T11:00:00+03:00)", Second)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if want := "[2024-01-01T10:00:00Z;2024-01-01T11:00:00+03:00)"; s.String() != want {
+		t.Errorf("Parse() = %s, want %s", s, want)
+	}
+	if _, err := Parse("[yesterday;+inf)", Second); err == nil {
+		t.Errorf("Parse() of invalid time error = nil")
+	}
+	from, till, err := segment.ParseBorders("[1h;90m)", ParseDuration(time.Minute))
+	if err != nil {
+		t.Fatalf("ParseBorders() error = %v", err)
+	}
+	ds := NewDurationSegment(from, till)
+	if ds.String() != "[1h0m0s;1h30m0s)" || !ds.IsIncludes(89*time.Minute) || ds.IsIncludes(90*time.Minute) {
+		t.Errorf("NewDurationSegment() = %s", ds)
+	}
+	if inc, _ := ds.TryTo(segment.Included, segment.Included); inc.(interface{ String() string }).String() != "[1h0m0s;1h29m0s]" {
+		t.Errorf("TryTo() = %v, want [1h0m0s;1h29m0s]", inc)
+	}
+}
+
+func TestTimeSegment_JSON(t *testing.T) {
+	s := &TimeSegment{}
+	if err := json.Unmarshal([]byte(`{"from":{"bound":"included","value":"2024-01-01T10:00:00Z"},"till":{"bound":"unbound"}}`), s); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if s.String() != "[2024-01-01T10:00:00Z;+inf)" {
+		t.Errorf("Unmarshal() = %s", s)
+	}
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != `{"from":{"bound":"included","value":"2024-01-01T10:00:00Z"},"till":{"bound":"unbound"}}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+	days := NewTimeSegment(segment.NewIncluded(Time(at(0, 0, 0), Day(nil))), segment.NewIncluded(Time(at(0, 0, 0), Day(nil))))
+	if err := days.UnmarshalText([]byte("[2024-02-01T15:00:00Z;2024-02-03T15:00:00Z]")); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if size, _ := days.Size(); size != 72*time.Hour {
+		t.Errorf("UnmarshalText() kept a wrong precision, Size() = %v, want 72h", size)
+	}
+}
@@ -0,0 +1,90 @@
+package timeseg
+
+import (
+	"github.com/pioniro/segment-go"
+	"math"
+	"time"
+)
+
+type timeValue struct {
+	value     time.Time
+	precision Precision
+}
+
+// Time returns a value of a time truncated to a given precision. A nil precision is treated as Nanosecond.
+func Time(t time.Time, p Precision) segment.Value[time.Time] {
+	if p == nil {
+		p = Nanosecond
+	}
+	return &timeValue{
+		value:     p.Truncate(t),
+		precision: p,
+	}
+}
+
+func (v *timeValue) Value() time.Time {
+	return v.value
+}
+
+// String returns a time in RFC 3339 format with fractional seconds if they are not zero: 2024-01-02T15:04:05.5Z
+func (v *timeValue) String() string {
+	return v.value.Format(time.RFC3339Nano)
+}
+
+// Next returns a beginning of a next step of a precision: 10:00:00 -> 10:00:01 for Second.
+func (v *timeValue) Next() (segment.Value[time.Time], error) {
+	return &timeValue{value: v.precision.Next(v.value), precision: v.precision}, nil
+}
+
+// Prev returns a beginning of a previous step of a precision: 10:00:00 -> 09:59:59 for Second.
+func (v *timeValue) Prev() (segment.Value[time.Time], error) {
+	return &timeValue{value: v.precision.Prev(v.value), precision: v.precision}, nil
+}
+
+// precisionOf returns a precision of a value, that was created with Time, or Nanosecond for other values.
+func precisionOf(v segment.Value[time.Time]) Precision {
+	if tv, ok := v.(*timeValue); ok {
+		return tv.precision
+	}
+	return Nanosecond
+}
+
+type durationValue struct {
+	value     time.Duration
+	precision time.Duration
+}
+
+// Duration returns a value of a duration truncated to a given precision: Next and Prev add and subtract the precision.
+// A precision less than 1ns is treated as 1ns.
+func Duration(d, precision time.Duration) segment.Value[time.Duration] {
+	precision = max(precision, time.Nanosecond)
+	return &durationValue{
+		value:     d.Truncate(precision),
+		precision: precision,
+	}
+}
+
+func (v *durationValue) Value() time.Duration {
+	return v.value
+}
+
+// String returns a duration in the format of time.Duration: 1h30m0s
+func (v *durationValue) String() string {
+	return v.value.String()
+}
+
+// Next returns a duration plus a precision or ErrHasNoNextValue in case of overflow.
+func (v *durationValue) Next() (segment.Value[time.Duration], error) {
+	if v.value > math.MaxInt64-v.precision {
+		return v, segment.ErrHasNoNextValue
+	}
+	return &durationValue{value: v.value + v.precision, precision: v.precision}, nil
+}
+
+// Prev returns a duration minus a precision or ErrHasNoPrevValue in case of overflow.
+func (v *durationValue) Prev() (segment.Value[time.Duration], error) {
+	if v.value < math.MinInt64+v.precision {
+		return v, segment.ErrHasNoPrevValue
+	}
+	return &durationValue{value: v.value - v.precision, precision: v.precision}, nil
+}
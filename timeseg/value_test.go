@@ -0,0 +1,90 @@
+package timeseg
+
+import (
+	"github.com/pioniro/segment-go"
+	"math"
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("location %s is not available: %v", name, err)
+	}
+	return loc
+}
+
+func TestTime(t *testing.T) {
+	base := time.Date(2024, 3, 10, 15, 4, 5, 123456789, time.UTC)
+	tests := []struct {
+		name string
+		p    Precision
+		want string
+		next string
+		prev string
+	}{
+		{name: "ns", p: Nanosecond, want: "2024-03-10T15:04:05.123456789Z", next: "2024-03-10T15:04:05.12345679Z", prev: "2024-03-10T15:04:05.123456788Z"},
+		{name: "ms", p: Millisecond, want: "2024-03-10T15:04:05.123Z", next: "2024-03-10T15:04:05.124Z", prev: "2024-03-10T15:04:05.122Z"},
+		{name: "s", p: Second, want: "2024-03-10T15:04:05Z", next: "2024-03-10T15:04:06Z", prev: "2024-03-10T15:04:04Z"},
+		{name: "minute", p: Fixed(time.Minute), want: "2024-03-10T15:04:00Z", next: "2024-03-10T15:05:00Z", prev: "2024-03-10T15:03:00Z"},
+		{name: "day", p: Day(time.UTC), want: "2024-03-10T00:00:00Z", next: "2024-03-11T00:00:00Z", prev: "2024-03-09T00:00:00Z"},
+		{name: "nil", p: nil, want: "2024-03-10T15:04:05.123456789Z", next: "2024-03-10T15:04:05.12345679Z", prev: "2024-03-10T15:04:05.123456788Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := Time(base, tt.p)
+			next, err := v.Next()
+			if err != nil {
+				t.Fatalf("Next() error = %v", err)
+			}
+			prev, err := v.Prev()
+			if err != nil {
+				t.Fatalf("Prev() error = %v", err)
+			}
+			if v.String() != tt.want || next.String() != tt.next || prev.String() != tt.prev {
+				t.Errorf("Time() = %s, %s, %s, want %s, %s, %s", v, next, prev, tt.want, tt.next, tt.prev)
+			}
+		})
+	}
+}
+
+func TestTime_DayDST(t *testing.T) {
+	loc := mustLoad(t, "America/New_York")
+	// DST starts on 2024-03-10, the day is 23 hours long
+	v := Time(time.Date(2024, 3, 10, 12, 0, 0, 0, loc), Day(loc))
+	next, _ := v.Next()
+	if got := next.Value().Sub(v.Value()); got != 23*time.Hour {
+		t.Errorf("Next() - value = %v, want 23h", got)
+	}
+	if got := next.String(); got != "2024-03-11T00:00:00-04:00" {
+		t.Errorf("Next() = %s, want 2024-03-11T00:00:00-04:00", got)
+	}
+	// a time in other location is truncated to a day of the location
+	utc := Time(time.Date(2024, 3, 10, 3, 0, 0, 0, time.UTC), Day(loc))
+	if got := utc.String(); got != "2024-03-09T00:00:00-05:00" {
+		t.Errorf("Time() = %s, want 2024-03-09T00:00:00-05:00", got)
+	}
+}
+
+func TestDuration(t *testing.T) {
+	v := Duration(90*time.Minute+30*time.Second, time.Minute)
+	if v.Value() != 90*time.Minute || v.String() != "1h30m0s" {
+		t.Errorf("Duration() = %s, want 1h30m0s", v)
+	}
+	next, err := v.Next()
+	if err != nil || next.Value() != 91*time.Minute {
+		t.Errorf("Next() = %v, %v, want 1h31m0s", next, err)
+	}
+	prev, err := v.Prev()
+	if err != nil || prev.Value() != 89*time.Minute {
+		t.Errorf("Prev() = %v, %v, want 1h29m0s", prev, err)
+	}
+	if _, err := Duration(math.MaxInt64, 0).Next(); err != segment.ErrHasNoNextValue {
+		t.Errorf("Next() of max error = %v, want ErrHasNoNextValue", err)
+	}
+	if _, err := Duration(math.MinInt64, 0).Prev(); err != segment.ErrHasNoPrevValue {
+		t.Errorf("Prev() of min error = %v, want ErrHasNoPrevValue", err)
+	}
+}
@@ -19,28 +19,55 @@ type Value[T any] interface {
 }
 
 type infValue[T any] struct {
+	sign int
 }
 
 // Inf returns a value, that is bigger than any other value of a given type (modulo; except inf itself)
 // Distance between any value (even Inf) and Inf is Inf.
 // Inf is not equal to Inf (our Inf has not a sign, so we can't compare them).
 // As Inf does not have a sign, so we cant make a segment (-Inf; -Inf) or (+Inf; +Inf), only (-Inf; +Inf) which the same as (Inf; Inf).
+// Use NegInf and PosInf if a side of infinity is known.
 func Inf[T any]() Value[T] {
 	return &infValue[T]{}
 }
 
+// NegInf returns a value, that is less than any other value of a given type, except NegInf itself.
+func NegInf[T any]() Value[T] {
+	return &infValue[T]{sign: -1}
+}
+
+// PosInf returns a value, that is bigger than any other value of a given type, except PosInf itself.
+func PosInf[T any]() Value[T] {
+	return &infValue[T]{sign: 1}
+}
+
+// InfSign returns a sign of an infinity: -1 for NegInf, 1 for PosInf and 0 for Inf.
+// If a value is not an infinity, then false will be returned.
+func InfSign[T any](v Value[T]) (int, bool) {
+	if i, ok := v.(*infValue[T]); ok {
+		return i.sign, true
+	}
+	return 0, false
+}
+
 func (i infValue[T]) String() string {
+	switch {
+	case i.sign < 0:
+		return "-inf"
+	case i.sign > 0:
+		return "+inf"
+	}
 	return "inf"
 }
 
 // Next returns an error, because it is not possible to calculate a next value for Inf.
 func (i infValue[T]) Next() (Value[T], error) {
-	return Inf[T](), ErrHasNoNextValue
+	return &infValue[T]{sign: i.sign}, ErrHasNoNextValue
 }
 
 // Prev returns an error, because it is not possible to calculate a prev value for Inf.
 func (i infValue[T]) Prev() (Value[T], error) {
-	return Inf[T](), ErrHasNoPrevValue
+	return &infValue[T]{sign: i.sign}, ErrHasNoPrevValue
 }
 
 func (i infValue[T]) Value() T {
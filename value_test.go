@@ -110,3 +110,40 @@ func Test_infValue_String(t *testing.T) {
 		t.Errorf("String() = %v, want %v", got, want)
 	}
 }
+
+func Test_infValue_String_Signed(t *testing.T) {
+	tests := []struct {
+		inf  Value[int64]
+		want string
+	}{
+		{inf: NegInf[int64](), want: "-inf"},
+		{inf: PosInf[int64](), want: "+inf"},
+	}
+	for _, tt := range tests {
+		if got := tt.inf.String(); got != tt.want {
+			t.Errorf("String() = %v, want %v", got, tt.want)
+		}
+	}
+}
+
+func TestInfSign(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        Value[int64]
+		wantSign int
+		wantOk   bool
+	}{
+		{name: "inf", v: Inf[int64](), wantSign: 0, wantOk: true},
+		{name: "-inf", v: NegInf[int64](), wantSign: -1, wantOk: true},
+		{name: "+inf", v: PosInf[int64](), wantSign: 1, wantOk: true},
+		{name: "1", v: NewTestValue(1), wantSign: 0, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sign, ok := InfSign(tt.v)
+			if sign != tt.wantSign || ok != tt.wantOk {
+				t.Errorf("InfSign() = %v, %v, want %v, %v", sign, ok, tt.wantSign, tt.wantOk)
+			}
+		})
+	}
+}